@@ -1,32 +1,133 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
 	"github.com/example/mcp-testing/internal/server"
 	"github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp"
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
 )
 
+var listen = flag.String("listen", ":50051", "comma-separated addresses to listen on: TCP host:port, or unix:/path/to/socket")
+var service = flag.String("service", "reference", "MCPService implementation to serve: reference (demo tools) or quotify")
+var corpusPath = flag.String("corpus", "", "with -service quotify, path to a JSON, YAML or CSV corpus file, or a directory of them; defaults to the built-in corpus")
+var reloadInterval = flag.Duration("reload-interval", 2*time.Second, "how often to poll -corpus for changes; 0 disables hot reload")
+var tlsCert = flag.String("tls-cert", "", "PEM certificate (chain) to serve TLS with; requires -tls-key. Plaintext if empty")
+var tlsKey = flag.String("tls-key", "", "PEM private key of -tls-cert")
+var tlsClientCA = flag.String("tls-client-ca", "", "with -tls-cert, PEM bundle of CAs to verify client certificates against; if set, clients must present one (mutual TLS)")
+var tlsReloadInterval = flag.Duration("tls-reload-interval", time.Minute, "how often to poll the TLS files for renewed certificates; 0 disables reloading")
+var shutdownTimeout = flag.Duration("shutdown-timeout", 10*time.Second, "how long to wait for in-flight calls on SIGINT or SIGTERM before closing them")
+
 func main() {
-	// Create a TCP listener on port 50051
-	lis, err := net.Listen("tcp", ":50051")
-	if err != nil {
-		log.Fatalf("Failed to listen on port 50051: %v", err)
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Create the listeners first, so that a bad address fails fast
+	var listeners []net.Listener
+	for _, addr := range strings.Split(*listen, ",") {
+		lis, err := server.Listen(strings.TrimSpace(addr))
+		if err != nil {
+			log.Fatalf("Failed to listen on %s: %v", addr, err)
+		}
+		listeners = append(listeners, lis)
 	}
 
-	// Create a new gRPC server
-	s := grpc.NewServer()
+	// Create a new gRPC server, with TLS if configured
+	// Streams are ended on shutdown, so that they don't hold it up
+	streams, stopStreams := context.WithCancel(context.Background())
+	opts := []grpc.ServerOption{grpc.ChainStreamInterceptor(server.StopStreams(streams))}
+	if *tlsCert != "" || *tlsKey != "" {
+		if *tlsCert == "" || *tlsKey == "" {
+			log.Fatalf("-tls-cert and -tls-key must be set together")
+		}
+		config, err := server.TLSConfig(ctx, *tlsCert, *tlsKey, *tlsClientCA, *tlsReloadInterval)
+		if err != nil {
+			log.Fatalf("Failed to set up TLS: %v", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(config)))
+	} else if *tlsClientCA != "" {
+		log.Fatalf("-tls-client-ca needs -tls-cert and -tls-key")
+	}
+	s := grpc.NewServer(opts...)
 
 	// Create and register the MCP server
-	mcpServer := server.NewMCPServer()
-	mcp.RegisterMCPServiceServer(s, mcpServer)
+	mcpServer, err := server.NewService(ctx, *service, *corpusPath, *reloadInterval)
+	if err != nil {
+		log.Fatalf("Failed to create %s service: %v", *service, err)
+	}
+	// Serve v2, and v1 for existing clients
+	mcpv2.RegisterMCPServiceServer(s, mcpServer)
+	mcp.RegisterMCPServiceServer(s, server.V1(mcpServer))
 
-	log.Printf("MCP gRPC server listening on :50051")
+	// Report health for the server as a whole ("") and for each service, and
+	// let grpcurl and friends discover the services
+	healthServer := health.NewServer()
+	for _, name := range []string{mcpv2.MCPService_ServiceDesc.ServiceName, mcp.MCPService_ServiceDesc.ServiceName} {
+		healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(s, healthServer)
+	reflection.Register(s)
 
 	// Start serving
-	if err := s.Serve(lis); err != nil {
+	errs := make(chan error, len(listeners))
+	for _, lis := range listeners {
+		log.Printf("MCP gRPC server (%s) listening on %s%s", *service, lis.Addr(), security())
+		go func() {
+			errs <- s.Serve(lis)
+		}()
+	}
+
+	select {
+	case err := <-errs:
 		log.Fatalf("Failed to serve: %v", err)
+	case <-ctx.Done():
+	}
+	// Restore the default signal handling, so a second SIGINT kills us
+	stop()
+
+	// Tell health checkers we are going away and end streams, then let
+	// in-flight calls finish, cutting them off after -shutdown-timeout
+	log.Printf("Shutting down, waiting up to %s for in-flight calls", *shutdownTimeout)
+	healthServer.Shutdown()
+	stopStreams()
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(*shutdownTimeout):
+		log.Printf("In-flight calls still running after %s, closing them", *shutdownTimeout)
+		s.Stop()
+	}
+	log.Printf("Server stopped")
+}
+
+// security describes how connections are secured, for the startup log.
+func security() string {
+	switch {
+	case *tlsClientCA != "":
+		return " with mutual TLS"
+	case *tlsCert != "":
+		return " with TLS"
+	default:
+		return " in plaintext"
 	}
-}
\ No newline at end of file
+}
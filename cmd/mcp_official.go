@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -90,8 +91,28 @@ func main() {
 	}, GreetingPrompt)
 
 	log.Printf("MCP server ready, starting to serve...")
+	if *httpAddr != "" {
+		if err := serveHTTP(server, *httpAddr); err != nil {
+			log.Printf("Server error: %v", err)
+			panic(err)
+		}
+		return
+	}
 	if err := server.Run(context.Background(), mcp.NewStdioTransport()); err != nil {
 		log.Printf("Server error: %v", err)
 		panic(err)
 	}
-}
\ No newline at end of file
+}
+
+// serveHTTP serves server over HTTP at addr: streamable HTTP on /mcp and the
+// legacy SSE transport on /sse. All clients share the one server.
+func serveHTTP(server *mcp.Server, addr string) error {
+	getServer := func(*http.Request) *mcp.Server { return server }
+
+	mux := http.NewServeMux()
+	mux.Handle("/mcp", mcp.NewStreamableHTTPHandler(getServer, nil))
+	mux.Handle("/sse", mcp.NewSSEHandler(getServer))
+
+	log.Printf("Serving streamable HTTP at http://%s/mcp and SSE at http://%s/sse", addr, addr)
+	return http.ListenAndServe(addr, mux)
+}
@@ -196,12 +196,7 @@ func handleRequest(req *JSONRPCRequest) {
 		case "echo":
 			text, ok := params.Arguments["text"]
 			if !ok {
-				sendResult(CallToolResult{
-					Content: []map[string]string{
-						{"type": "text", "text": "Error: missing 'text' argument"},
-					},
-					IsError: true,
-				}, req.ID)
+				sendError(-32602, "Invalid params: missing 'text' argument", req.ID)
 				return
 			}
 			
@@ -213,32 +208,22 @@ func handleRequest(req *JSONRPCRequest) {
 			}, req.ID)
 			
 		case "add":
-			a, aOk := params.Arguments["a"]
-			b, bOk := params.Arguments["b"]
+			a, aOk := params.Arguments["a"].(float64)
+			b, bOk := params.Arguments["b"].(float64)
 			if !aOk || !bOk {
-				sendResult(CallToolResult{
-					Content: []map[string]string{
-						{"type": "text", "text": "Error: missing 'a' or 'b' argument"},
-					},
-					IsError: true,
-				}, req.ID)
+				sendError(-32602, "Invalid params: missing or non-numeric 'a' or 'b' argument", req.ID)
 				return
 			}
 			
 			sendResult(CallToolResult{
 				Content: []map[string]string{
-					{"type": "text", "text": fmt.Sprintf("Result: %v + %v", a, b)},
+					{"type": "text", "text": fmt.Sprintf("Result: %v", a+b)},
 				},
 				IsError: false,
 			}, req.ID)
 			
 		default:
-			sendResult(CallToolResult{
-				Content: []map[string]string{
-					{"type": "text", "text": fmt.Sprintf("Error: unknown tool '%s'", params.Name)},
-				},
-				IsError: true,
-			}, req.ID)
+			sendError(-32601, fmt.Sprintf("Unknown tool '%s'", params.Name), req.ID)
 		}
 		
 	default:
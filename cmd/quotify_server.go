@@ -2,48 +2,449 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/modelcontextprotocol/go-sdk/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+
 	"github.com/example/mcp-testing/pkg/quotify"
 )
 
 var httpAddr = flag.String("http", "", "if set, use streamable HTTP at this address, instead of stdin/stdout")
+var corpusPath = flag.String("corpus", "", "path to a JSON, YAML or CSV corpus file, or a directory of them; defaults to the built-in corpus")
+var reloadInterval = flag.Duration("reload-interval", 2*time.Second, "how often to poll -corpus for changes; 0 disables hot reload")
+var noRepeat = flag.Bool("no-repeat", false, "serve every quote once per session before repeating any")
+var noRepeatAuthors = flag.Bool("no-repeat-authors", false, "with -no-repeat, also serve every author once per session before repeating any")
+var pageSize = flag.Int("page-size", 100, "maximum number of tools, prompts or resources returned by a single list request")
+
+// quotifier serves every tool call. It is set up in main from -corpus and
+// swapped in place whenever the corpus is reloaded.
+var quotifier atomic.Pointer[quotify.Quotify]
+
+// sessionState is the per-client state kept by the quotify tools, so that
+// separate clients do not interfere with each other.
+type sessionState struct {
+	mu  sync.Mutex
+	bag *quotify.Bag
+
+	// quiz is the game in progress, if any. The totals accumulate over every
+	// quiz played in the session.
+	quiz                       *quotify.Quiz
+	quizzes, correct, answered int
+}
+
+var (
+	sessionsMu sync.Mutex
+	sessions   = make(map[*mcp.ServerSession]*sessionState)
+)
+
+// stateFor returns the state for ss, creating it on first use. The state is
+// dropped once the client disconnects.
+func stateFor(ss *mcp.ServerSession) *sessionState {
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+
+	st, ok := sessions[ss]
+	if !ok {
+		st = &sessionState{}
+		sessions[ss] = st
+		go func() {
+			ss.Wait()
+			sessionsMu.Lock()
+			delete(sessions, ss)
+			sessionsMu.Unlock()
+		}()
+	}
+	return st
+}
+
+// bagFor returns the session's shuffle bag over q, starting a fresh one if
+// the corpus has been reloaded since the bag was created.
+func (st *sessionState) bagFor(q *quotify.Quotify) *quotify.Bag {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if st.bag == nil || st.bag.Quotify() != q {
+		st.bag = q.NewBag(*noRepeatAuthors)
+	}
+	return st.bag
+}
+
+// errorResult reports a tool failure to the client.
+func errorResult(format string, args ...any) *mcp.CallToolResultFor[struct{}] {
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: "Error: " + fmt.Sprintf(format, args...)},
+		},
+		IsError: true,
+	}
+}
 
-type QuotifyArgs struct {
-	Format string `json:"format,omitempty" jsonschema:"format for the quote output: 'json' or 'text' (default: text)"`
+// textResult returns text as the tool's output.
+func textResult(text string) *mcp.CallToolResultFor[struct{}] {
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: text},
+		},
+	}
 }
 
-func QuotifyTool(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[QuotifyArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+// QuotifyArgs and QuotifyResult are the quotify tool's arguments and
+// structured output, shared with the gRPC server. The rendered quotes are
+// also returned as text content for clients that do not read structured
+// output.
+type (
+	QuotifyArgs   = quotify.ToolArgs
+	QuotifyResult = quotify.ToolResult
+)
+
+// QuotifyTool reports failures as errors rather than error results, so that
+// the client never receives structured content that does not match the
+// output schema.
+func QuotifyTool(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[QuotifyArgs]) (*mcp.CallToolResultFor[QuotifyResult], error) {
 	log.Printf("Quotify tool called with format: %s", params.Arguments.Format)
-	
-	q := quotify.New()
-	quote := q.Generate()
-	
-	var response string
-	
-	switch params.Arguments.Format {
-	case "json":
-		jsonData, err := json.MarshalIndent(quote, "", "  ")
+
+	q := quotifier.Load()
+	var bag *quotify.Bag
+	if *noRepeat {
+		bag = stateFor(ss).bagFor(q)
+	}
+	result, text, err := q.RunTool(params.Arguments, bag)
+	if err != nil {
+		log.Printf("Quotify tool failed: %v", err)
+		return nil, err
+	}
+	content := []mcp.Content{
+		&mcp.TextContent{Text: text},
+	}
+	if params.Arguments.Cards {
+		for _, quote := range result.Quotes {
+			content = append(content, &mcp.ImageContent{Data: quote.Card(), MIMEType: quotify.CardMIMEType})
+		}
+	}
+	return &mcp.CallToolResultFor[QuotifyResult]{
+		Content:           content,
+		StructuredContent: result,
+	}, nil
+}
+
+// quotifyInputSchema is the schema inferred from QuotifyArgs, with the
+// registered output formats listed as an enum.
+func quotifyInputSchema() *jsonschema.Schema {
+	schema, err := jsonschema.For[QuotifyArgs]()
+	if err != nil {
+		log.Fatalf("Failed to infer quotify input schema: %v", err)
+	}
+	var formats []any
+	for _, name := range quotify.ToolFormats() {
+		formats = append(formats, name)
+	}
+	schema.Properties["format"].Enum = formats
+	return schema
+}
+
+// Search result page sizes.
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+type QuotifySearchArgs struct {
+	Query  string `json:"query" jsonschema:"words, a phrase or a regular expression to look for"`
+	Mode   string `json:"mode,omitempty" jsonschema:"'keyword' ranks quotes by how well they match any of the words (default), 'phrase' requires the words in order, 'regex' matches the quote text against a case-insensitive regular expression"`
+	Limit  int    `json:"limit,omitempty" jsonschema:"maximum number of results per page (default 10, at most 50)"`
+	Cursor string `json:"cursor,omitempty" jsonschema:"next_cursor from a previous call with the same query and mode, to fetch the following page"`
+}
+
+// QuotifySearchResult is the structured output of the quotify_search tool.
+type QuotifySearchResult struct {
+	Results    []quotify.SearchHit `json:"results" jsonschema:"the matching quotes on this page, best first"`
+	Total      int                 `json:"total" jsonschema:"the number of matching quotes across all pages"`
+	NextCursor string              `json:"next_cursor,omitempty" jsonschema:"pass as cursor to fetch the next page; absent on the last page"`
+}
+
+// searchCursor is the decoded form of a quotify_search cursor. It carries the
+// query so that a cursor cannot be replayed against a different search.
+type searchCursor struct {
+	Query  string `json:"q"`
+	Mode   string `json:"m"`
+	Offset int    `json:"o"`
+}
+
+func encodeSearchCursor(c searchCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeSearchCursor(s string) (searchCursor, error) {
+	var c searchCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil || c.Offset < 0 {
+		return searchCursor{}, errors.New("invalid cursor")
+	}
+	return c, nil
+}
+
+func QuotifySearchTool(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[QuotifySearchArgs]) (*mcp.CallToolResultFor[QuotifySearchResult], error) {
+	log.Printf("Quotify search tool called with query: %s", params.Arguments.Query)
+
+	args := params.Arguments
+	limit := args.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	if limit < 0 || limit > maxSearchLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d", maxSearchLimit)
+	}
+	offset := 0
+	if args.Cursor != "" {
+		c, err := decodeSearchCursor(args.Cursor)
 		if err != nil {
-			log.Printf("Error marshaling quote to JSON: %v", err)
-			return &mcp.CallToolResultFor[struct{}]{
-				Content: []mcp.Content{
-					&mcp.TextContent{Text: "Error generating JSON quote"},
-				},
-			}, nil
+			return nil, err
 		}
-		response = string(jsonData)
-	default:
-		response = q.GenerateString()
+		if c.Query != args.Query || c.Mode != args.Mode {
+			return nil, errors.New("cursor belongs to a different query or mode")
+		}
+		offset = c.Offset
 	}
-	
-	return &mcp.CallToolResultFor[struct{}]{
+
+	hits, err := quotifier.Load().Search(args.Query, args.Mode)
+	if err != nil {
+		return nil, err
+	}
+	res := QuotifySearchResult{Total: len(hits), Results: []quotify.SearchHit{}}
+	if offset < len(hits) {
+		end := min(offset+limit, len(hits))
+		res.Results = hits[offset:end]
+		if end < len(hits) {
+			res.NextCursor = encodeSearchCursor(searchCursor{Query: args.Query, Mode: args.Mode, Offset: end})
+		}
+	}
+
+	var b strings.Builder
+	if res.Total == 0 {
+		fmt.Fprintf(&b, "No quotes match %q.", args.Query)
+	} else {
+		fmt.Fprintf(&b, "Showing %d-%d of %d quotes matching %q:\n", offset+1, offset+len(res.Results), res.Total, args.Query)
+		for i, h := range res.Results {
+			fmt.Fprintf(&b, "\n%d. \"%s\"", offset+i+1, h.Quote.Text)
+			if h.Quote.Author != "" {
+				fmt.Fprintf(&b, " - %s", h.Quote.Author)
+			}
+			fmt.Fprintf(&b, " [id %s]", h.Quote.ID)
+		}
+		if res.NextCursor != "" {
+			fmt.Fprintf(&b, "\n\nMore results: call again with cursor %q.", res.NextCursor)
+		}
+	}
+	return &mcp.CallToolResultFor[QuotifySearchResult]{
 		Content: []mcp.Content{
-			&mcp.TextContent{Text: response},
+			&mcp.TextContent{Text: b.String()},
+		},
+		StructuredContent: res,
+	}, nil
+}
+
+type QuizStartArgs struct {
+	Rounds  int `json:"rounds,omitempty" jsonschema:"number of rounds to play (default 5)"`
+	Choices int `json:"choices,omitempty" jsonschema:"number of candidate authors per round (default 4)"`
+
+	Category   string   `json:"category,omitempty" jsonschema:"only ask quotes in this category, e.g. movies"`
+	Tags       []string `json:"tags,omitempty" jsonschema:"only ask quotes carrying all of these tags"`
+	AuthorTags []string `json:"author_tags,omitempty" jsonschema:"only ask quotes whose real author carries all of these tags"`
+}
+
+type QuizAnswerArgs struct {
+	Answer string `json:"answer" jsonschema:"the guessed author: a name, a choice letter such as 'B', or a choice number"`
+}
+
+type QuizScoreArgs struct{}
+
+func QuizStartTool(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[QuizStartArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	log.Printf("Quiz start tool called with %d rounds", params.Arguments.Rounds)
+
+	filter := quotify.Filter{
+		QuoteTags:  params.Arguments.Tags,
+		AuthorTags: params.Arguments.AuthorTags,
+	}
+	if params.Arguments.Category != "" {
+		filter.QuoteTags = slices.Concat(filter.QuoteTags, []string{params.Arguments.Category})
+	}
+	quiz, err := quotifier.Load().NewQuiz(params.Arguments.Rounds, params.Arguments.Choices, filter)
+	if err != nil {
+		return errorResult("%v", err), nil
+	}
+
+	st := stateFor(ss)
+	st.mu.Lock()
+	st.quiz = quiz
+	st.quizzes++
+	st.mu.Unlock()
+
+	return textResult("Who really said it? Answer each round with quiz_answer.\n\n" + formatQuizQuestion(quiz.Question())), nil
+}
+
+func QuizAnswerTool(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[QuizAnswerArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	log.Printf("Quiz answer tool called with answer: %s", params.Arguments.Answer)
+
+	st := stateFor(ss)
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if st.quiz == nil {
+		return errorResult("no quiz in progress; start one with quiz_start"), nil
+	}
+	res, err := st.quiz.Answer(params.Arguments.Answer)
+	if errors.Is(err, quotify.ErrQuizOver) {
+		return errorResult("the quiz is over; start a new one with quiz_start"), nil
+	}
+	if err != nil {
+		return errorResult("%v", err), nil
+	}
+	st.answered++
+	if res.Correct {
+		st.correct++
+	}
+
+	var b strings.Builder
+	if res.Correct {
+		b.WriteString("Correct! ")
+	} else {
+		b.WriteString("Wrong! ")
+	}
+	fmt.Fprintf(&b, "It was %s", res.Author)
+	if res.Source != "" {
+		fmt.Fprintf(&b, " (%s)", res.Source)
+	}
+	fmt.Fprintf(&b, ".\nScore: %d/%d\n", res.Score.Correct, res.Score.Answered)
+	if res.Next != nil {
+		b.WriteString("\n" + formatQuizQuestion(res.Next))
+	} else {
+		fmt.Fprintf(&b, "\nQuiz over! Final score: %d out of %d.", res.Score.Correct, res.Score.Rounds)
+	}
+	return textResult(b.String()), nil
+}
+
+func QuizScoreTool(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[QuizScoreArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	log.Printf("Quiz score tool called")
+
+	st := stateFor(ss)
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if st.quiz == nil {
+		return textResult("No quiz played yet; start one with quiz_start."), nil
+	}
+	score := st.quiz.Score()
+	var b strings.Builder
+	if score.Finished {
+		fmt.Fprintf(&b, "Last quiz: %d out of %d (finished).\n", score.Correct, score.Rounds)
+	} else {
+		fmt.Fprintf(&b, "Current quiz: %d out of %d answered correctly, round %d of %d.\n", score.Correct, score.Answered, score.Answered+1, score.Rounds)
+	}
+	fmt.Fprintf(&b, "This session: %d correct out of %d answers across %d quizzes.", st.correct, st.answered, st.quizzes)
+	return textResult(b.String()), nil
+}
+
+// formatQuizQuestion renders a quiz round with lettered choices.
+func formatQuizQuestion(qq *quotify.QuizQuestion) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Round %d of %d:\n\"%s\"\n", qq.Round, qq.Rounds, qq.Text)
+	for i, c := range qq.Choices {
+		fmt.Fprintf(&b, "\n%s. %s", quotify.ChoiceLabel(i), c)
+	}
+	return b.String()
+}
+
+// CorpusResource serves the corpus resources and resource templates. Quote
+// cards are sent as binary contents.
+func CorpusResource(ctx context.Context, ss *mcp.ServerSession, params *mcp.ReadResourceParams) (*mcp.ReadResourceResult, error) {
+	log.Printf("Resource read: %s", params.URI)
+
+	data, mimeType, err := quotifier.Load().ReadResource(params.URI)
+	if errors.Is(err, quotify.ErrResourceNotFound) {
+		return nil, mcp.ResourceNotFoundError(params.URI)
+	}
+	if err != nil {
+		return nil, err
+	}
+	contents := &mcp.ResourceContents{URI: params.URI, MIMEType: mimeType}
+	if mimeType == quotify.ResourceMIMEType {
+		contents.Text = string(data)
+	} else {
+		contents.Blob = data
+	}
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{contents},
+	}, nil
+}
+
+// promptHandler returns the handler rendering p around a fresh quote as a
+// single user message.
+func promptHandler(p quotify.Prompt) mcp.PromptHandler {
+	return func(ctx context.Context, ss *mcp.ServerSession, params *mcp.GetPromptParams) (*mcp.GetPromptResult, error) {
+		log.Printf("Prompt %s called with topic: %s", p.Name, params.Arguments["topic"])
+
+		description, text, err := p.Render(quotifier.Load(), params.Arguments)
+		if err != nil {
+			return nil, err
+		}
+		return &mcp.GetPromptResult{
+			Description: description,
+			Messages: []*mcp.PromptMessage{
+				{Role: "user", Content: &mcp.TextContent{Text: text}},
+			},
+		}, nil
+	}
+}
+
+// maxCompletions is the most values a completion response may carry.
+const maxCompletions = 100
+
+// CompleteArgument suggests values for prompt and resource template
+// arguments, going by the argument name: authors for author and name, tags
+// for topic and quote IDs for id.
+func CompleteArgument(ctx context.Context, ss *mcp.ServerSession, params *mcp.CompleteParams) (*mcp.CompleteResult, error) {
+	log.Printf("Completion requested for argument: %s", params.Argument.Name)
+
+	q := quotifier.Load()
+	var candidates []string
+	switch params.Argument.Name {
+	case "author", "name":
+		candidates = q.AuthorNames()
+	case "topic":
+		candidates = q.Tags()
+	case "id":
+		for _, e := range q.Quotes {
+			candidates = append(candidates, e.EffectiveID())
+		}
+	}
+
+	values := quotify.Complete(candidates, params.Argument.Value)
+	total := len(values)
+	if total > maxCompletions {
+		values = values[:maxCompletions]
+	}
+	return &mcp.CompleteResult{
+		Completion: mcp.CompletionResultDetails{
+			Values:  append([]string{}, values...),
+			Total:   total,
+			HasMore: total > len(values),
 		},
 	}, nil
 }
@@ -54,21 +455,108 @@ func main() {
 	log.Printf("Starting Quotify MCP server...")
 
 	flag.Parse()
+	if *pageSize < 1 {
+		log.Fatalf("Invalid -page-size %d: must be at least 1", *pageSize)
+	}
+
+	ctx := context.Background()
+
+	quotifier.Store(quotify.New())
+	if *corpusPath != "" {
+		if err := quotify.LoadAndWatch(ctx, *corpusPath, *reloadInterval, quotifier.Store); err != nil {
+			log.Fatalf("Failed to load corpus: %v", err)
+		}
+	}
 
 	server := mcp.NewServer(&mcp.Implementation{
 		Name:    "quotify-server",
 		Version: "1.0.0",
-	}, nil)
+	}, &mcp.ServerOptions{
+		CompletionHandler: CompleteArgument,
+		PageSize:          *pageSize,
+	})
 
 	// Add quotify tool
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "quotify",
-		Description: "Generate a random quote with a random author attribution in the style of the original quotify Ruby gem",
+		Description: quotify.ToolDescription,
+		InputSchema: quotifyInputSchema(),
 	}, QuotifyTool)
 
+	// Add search tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "quotify_search",
+		Description: "Search the corpus by keyword, phrase or regular expression, e.g. to find that quote about hammers",
+	}, QuotifySearchTool)
+
+	// Add quiz tools
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "quiz_start",
+		Description: "Start a multi-round 'who really said it?' quiz; each round shows a quote and multiple-choice authors",
+	}, QuizStartTool)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "quiz_answer",
+		Description: "Answer the current quiz round and get the next one",
+	}, QuizAnswerTool)
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "quiz_score",
+		Description: "Show the score of the current quiz and of all quizzes in this session",
+	}, QuizScoreTool)
+
+	// Add quote prompts
+	for _, p := range quotify.Prompts {
+		var args []*mcp.PromptArgument
+		for _, a := range p.Args {
+			args = append(args, &mcp.PromptArgument{Name: a.Name, Description: a.Description, Required: a.Required})
+		}
+		server.AddPrompt(&mcp.Prompt{
+			Name:        p.Name,
+			Description: p.Description,
+			Arguments:   args,
+		}, promptHandler(p))
+	}
+
+	// Add corpus resources
+	for _, r := range quotify.Resources {
+		server.AddResource(&mcp.Resource{
+			Name:        r.Name,
+			Description: r.Description,
+			MIMEType:    r.MIMEType,
+			URI:         r.URI,
+		}, CorpusResource)
+	}
+	for _, r := range quotify.ResourceTemplates {
+		server.AddResourceTemplate(&mcp.ResourceTemplate{
+			Name:        r.Name,
+			Description: r.Description,
+			MIMEType:    r.MIMEType,
+			URITemplate: r.URI,
+		}, CorpusResource)
+	}
+
 	log.Printf("Quotify MCP server ready, starting to serve...")
-	if err := server.Run(context.Background(), mcp.NewStdioTransport()); err != nil {
+	if *httpAddr != "" {
+		if err := serveHTTP(server, *httpAddr); err != nil {
+			log.Printf("Server error: %v", err)
+			panic(err)
+		}
+		return
+	}
+	if err := server.Run(ctx, mcp.NewStdioTransport()); err != nil {
 		log.Printf("Server error: %v", err)
 		panic(err)
 	}
-}
\ No newline at end of file
+}
+
+// serveHTTP serves server over HTTP at addr: streamable HTTP on /mcp and the
+// legacy SSE transport on /sse. All clients share the one server.
+func serveHTTP(server *mcp.Server, addr string) error {
+	getServer := func(*http.Request) *mcp.Server { return server }
+
+	mux := http.NewServeMux()
+	mux.Handle("/mcp", mcp.NewStreamableHTTPHandler(getServer, nil))
+	mux.Handle("/sse", mcp.NewSSEHandler(getServer))
+
+	log.Printf("Serving streamable HTTP at http://%s/mcp and SSE at http://%s/sse", addr, addr)
+	return http.ListenAndServe(addr, mux)
+}
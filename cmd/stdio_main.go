@@ -1,226 +1,94 @@
 package main
 
 import (
-	"bufio"
 	"context"
-	"encoding/json"
+	"crypto/tls"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"os"
-	"strings"
+	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/example/mcp-testing/internal/bridge"
 	"github.com/example/mcp-testing/internal/server"
+	"github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp"
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
 )
 
-// JSON-RPC 2.0 message structure
-type JSONRPCRequest struct {
-	Jsonrpc string      `json:"jsonrpc"`
-	Method  string      `json:"method"`
-	Params  interface{} `json:"params,omitempty"`
-	ID      interface{} `json:"id"`
-}
-
-type JSONRPCResponse struct {
-	Jsonrpc string      `json:"jsonrpc"`
-	Result  interface{} `json:"result,omitempty"`
-	Error   *RPCError   `json:"error,omitempty"`
-	ID      interface{} `json:"id"`
-}
-
-type RPCError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-}
-
-// MCP protocol types for stdio transport
-type InitializeParams struct {
-	ProtocolVersion string                 `json:"protocolVersion"`
-	Capabilities    map[string]interface{} `json:"capabilities"`
-	ClientInfo      map[string]string      `json:"clientInfo"`
-}
-
-type InitializeResult struct {
-	ProtocolVersion string                 `json:"protocolVersion"`
-	Capabilities    map[string]interface{} `json:"capabilities"`
-	ServerInfo      map[string]string      `json:"serverInfo"`
-}
-
-type ListToolsResult struct {
-	Tools []map[string]interface{} `json:"tools"`
-}
-
-type CallToolParams struct {
-	Name      string            `json:"name"`
-	Arguments map[string]string `json:"arguments"`
-}
-
-type CallToolResult struct {
-	Content []map[string]string `json:"content"`
-	IsError bool                `json:"isError"`
-}
+var addr = flag.String("addr", "", "address of a remote MCPService to bridge, e.g. localhost:50051; if empty, -service runs in-process")
+var v1 = flag.Bool("v1", false, "with -addr, bridge a server that only serves the v1 MCPService")
+var tlsCA = flag.String("tls-ca", "", "with -addr, PEM bundle of CAs to verify the server's certificate against; enables TLS")
+var tlsCert = flag.String("tls-cert", "", "with -tls-ca, PEM client certificate to present, for servers requiring mutual TLS")
+var tlsKey = flag.String("tls-key", "", "PEM private key of -tls-cert")
+var service = flag.String("service", "reference", "MCPService implementation to run in-process when -addr is empty: reference (demo tools) or quotify")
+var corpusPath = flag.String("corpus", "", "with -service quotify, path to a JSON, YAML or CSV corpus file, or a directory of them; defaults to the built-in corpus")
+var reloadInterval = flag.Duration("reload-interval", 2*time.Second, "how often to poll -corpus for changes; 0 disables hot reload")
 
 func main() {
-	// Disable regular logging to avoid interfering with stdio
+	// Log to stderr so it doesn't interfere with MCP stdio
 	log.SetOutput(os.Stderr)
-	
-	mcpServer := server.NewMCPServer()
-	scanner := bufio.NewScanner(os.Stdin)
-	
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-		
-		var req JSONRPCRequest
-		if err := json.Unmarshal([]byte(line), &req); err != nil {
-			sendError(-32700, "Parse error", req.ID)
-			continue
-		}
-		
-		handleRequest(mcpServer, &req)
-	}
-}
+	flag.Parse()
 
-func handleRequest(mcpServer *server.MCPServer, req *JSONRPCRequest) {
 	ctx := context.Background()
-	
-	switch req.Method {
-	case "initialize":
-		var params InitializeParams
-		if err := mapToStruct(req.Params, &params); err != nil {
-			sendError(-32602, "Invalid params", req.ID)
-			return
-		}
-		
-		// Convert to gRPC request
-		grpcReq := &mcpProto.InitializeRequest{
-			ProtocolVersion: params.ProtocolVersion,
-			Capabilities: &mcpProto.ClientCapabilities{
-				Roots:    getBool(params.Capabilities, "roots"),
-				Sampling: getBool(params.Capabilities, "sampling"),
-			},
-			ClientInfo: &mcpProto.ClientInfo{
-				Name:    params.ClientInfo["name"],
-				Version: params.ClientInfo["version"],
-			},
-		}
-		
-		resp, err := mcpServer.Initialize(ctx, grpcReq)
+
+	var client mcpv2.MCPServiceClient
+	if *addr != "" {
+		creds, err := transportCredentials()
 		if err != nil {
-			sendError(-32603, "Internal error", req.ID)
-			return
+			log.Fatalf("Failed to set up TLS: %v", err)
 		}
-		
-		result := InitializeResult{
-			ProtocolVersion: resp.ProtocolVersion,
-			Capabilities: map[string]interface{}{
-				"logging":   resp.Capabilities.Logging,
-				"prompts":   resp.Capabilities.Prompts,
-				"resources": resp.Capabilities.Resources,
-				"tools":     resp.Capabilities.Tools,
-			},
-			ServerInfo: map[string]string{
-				"name":    resp.ServerInfo.Name,
-				"version": resp.ServerInfo.Version,
-			},
-		}
-		
-		sendResult(result, req.ID)
-		
-	case "tools/list":
-		grpcReq := &mcpProto.ListToolsRequest{}
-		resp, err := mcpServer.ListTools(ctx, grpcReq)
+		conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(creds))
 		if err != nil {
-			sendError(-32603, "Internal error", req.ID)
-			return
-		}
-		
-		var tools []map[string]interface{}
-		for _, tool := range resp.Tools {
-			tools = append(tools, map[string]interface{}{
-				"name":        tool.Name,
-				"description": tool.Description,
-				"inputSchema": tool.InputSchema,
-			})
+			log.Fatalf("Failed to connect to %s: %v", *addr, err)
 		}
-		
-		sendResult(ListToolsResult{Tools: tools}, req.ID)
-		
-	case "tools/call":
-		var params CallToolParams
-		if err := mapToStruct(req.Params, &params); err != nil {
-			sendError(-32602, "Invalid params", req.ID)
-			return
+		defer conn.Close()
+		if *v1 {
+			client = bridge.FromV1(mcp.NewMCPServiceClient(conn))
+			log.Printf("Bridging stdio to v1 MCPService at %s", *addr)
+		} else {
+			client = mcpv2.NewMCPServiceClient(conn)
+			log.Printf("Bridging stdio to MCPService at %s", *addr)
 		}
-		
-		grpcReq := &mcpProto.CallToolRequest{
-			Name:      params.Name,
-			Arguments: params.Arguments,
-		}
-		
-		resp, err := mcpServer.CallTool(ctx, grpcReq)
+	} else {
+		srv, err := server.NewService(ctx, *service, *corpusPath, *reloadInterval)
 		if err != nil {
-			sendError(-32603, "Internal error", req.ID)
-			return
-		}
-		
-		var content []map[string]string
-		for _, c := range resp.Content {
-			content = append(content, map[string]string{
-				"type": c.Type,
-				"text": c.Text,
-			})
+			log.Fatalf("Failed to create %s service: %v", *service, err)
 		}
-		
-		sendResult(CallToolResult{
-			Content: content,
-			IsError: resp.IsError,
-		}, req.ID)
-		
-	default:
-		sendError(-32601, "Method not found", req.ID)
+		client = bridge.InProcess(srv)
+		log.Printf("Bridging stdio to the in-process %s server", *service)
 	}
-}
 
-func sendResult(result interface{}, id interface{}) {
-	resp := JSONRPCResponse{
-		Jsonrpc: "2.0",
-		Result:  result,
-		ID:      id,
+	if err := bridge.New(client).Serve(ctx, os.Stdin, os.Stdout); err != nil {
+		log.Fatalf("Bridge error: %v", err)
 	}
-	
-	data, _ := json.Marshal(resp)
-	fmt.Println(string(data))
 }
 
-func sendError(code int, message string, id interface{}) {
-	resp := JSONRPCResponse{
-		Jsonrpc: "2.0",
-		Error: &RPCError{
-			Code:    code,
-			Message: message,
-		},
-		ID: id,
+// transportCredentials returns TLS credentials if -tls-ca is set, with the
+// client certificate from -tls-cert and -tls-key if those are, and
+// plaintext otherwise.
+func transportCredentials() (credentials.TransportCredentials, error) {
+	if *tlsCA == "" {
+		if *tlsCert != "" || *tlsKey != "" {
+			return nil, errors.New("-tls-cert and -tls-key need -tls-ca")
+		}
+		return insecure.NewCredentials(), nil
 	}
-	
-	data, _ := json.Marshal(resp)
-	fmt.Println(string(data))
-}
-
-func mapToStruct(input interface{}, output interface{}) error {
-	data, err := json.Marshal(input)
+	pool, err := server.LoadCertPool(*tlsCA)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return json.Unmarshal(data, output)
-}
-
-func getBool(m map[string]interface{}, key string) bool {
-	if val, ok := m[key]; ok {
-		if b, ok := val.(bool); ok {
-			return b
+	config := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	if *tlsCert != "" || *tlsKey != "" {
+		cert, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
 		}
+		config.Certificates = []tls.Certificate{cert}
 	}
-	return false
-}
\ No newline at end of file
+	return credentials.NewTLS(config), nil
+}
@@ -0,0 +1,518 @@
+// Package bridge exposes a v2 MCPService over the MCP stdio transport, which is
+// newline-delimited JSON-RPC 2.0, so that gRPC-based servers can be used from
+// clients such as Claude Desktop.
+package bridge
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/example/mcp-testing/internal/server"
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
+)
+
+// JSON-RPC error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+
+	// MCP's code for reads of unknown resources.
+	codeResourceNotFound = -32002
+)
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// invalidParams reports params that could not be decoded.
+func invalidParams(err error) error {
+	return &rpcError{Code: codeInvalidParams, Message: "Invalid params: " + err.Error()}
+}
+
+// Bridge translates MCP JSON-RPC requests into MCPService calls.
+type Bridge struct {
+	client   mcpv2.MCPServiceClient
+	handlers map[string]func(context.Context, json.RawMessage) (any, error)
+}
+
+// New returns a bridge forwarding to client. Use InProcess to bridge a server
+// implementation running in the same process.
+func New(client mcpv2.MCPServiceClient) *Bridge {
+	b := &Bridge{client: client}
+	b.handlers = map[string]func(context.Context, json.RawMessage) (any, error){
+		"initialize":     b.initialize,
+		"ping":           b.ping,
+		"tools/list":     b.listTools,
+		"tools/call":     b.callTool,
+		"prompts/list":   b.listPrompts,
+		"prompts/get":    b.getPrompt,
+		"resources/list": b.listResources,
+		"resources/read": b.readResource,
+	}
+	return b
+}
+
+// Serve reads requests from r and writes responses to w until r is
+// exhausted or ctx is cancelled. Requests are handled one at a time, in
+// order.
+func (b *Bridge) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	in := bufio.NewReader(r)
+	enc := json.NewEncoder(w)
+	for {
+		line, err := in.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if resp := b.handle(ctx, line); resp != nil {
+				if err := enc.Encode(resp); err != nil {
+					return err
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// handle processes a single message, returning the response to send, or nil
+// for notifications.
+func (b *Bridge) handle(ctx context.Context, line []byte) *response {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return &response{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &rpcError{Code: codeParseError, Message: "Parse error"}}
+	}
+	if len(req.ID) == 0 {
+		log.Printf("Ignoring notification %s", req.Method)
+		return nil
+	}
+	resp := &response{JSONRPC: "2.0", ID: req.ID}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		resp.Error = &rpcError{Code: codeInvalidRequest, Message: "Invalid request"}
+		return resp
+	}
+
+	h, ok := b.handlers[req.Method]
+	if !ok {
+		resp.Error = &rpcError{Code: codeMethodNotFound, Message: "Method not found: " + req.Method}
+		return resp
+	}
+	result, err := h(ctx, req.Params)
+	if err != nil {
+		log.Printf("%s failed: %v", req.Method, err)
+		resp.Error = errorFor(err)
+		return resp
+	}
+	resp.Result = result
+	return resp
+}
+
+// errorFor converts a handler error into a JSON-RPC error. gRPC errors are
+// mapped the way MCP reports them: unknown resources as -32002 with the URI,
+// unknown tools and prompts and bad arguments as invalid params, with the
+// status details as data.
+func errorFor(err error) *rpcError {
+	var rerr *rpcError
+	if errors.As(err, &rerr) {
+		return rerr
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return &rpcError{Code: codeInternalError, Message: err.Error()}
+	}
+	rerr = &rpcError{Code: codeInternalError, Message: st.Message()}
+	data := map[string]any{}
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			if d.Domain == server.ErrorDomain && d.Reason == server.ReasonResourceNotFound {
+				rerr.Code = codeResourceNotFound
+			}
+			data["reason"] = d.Reason
+			for k, v := range d.Metadata {
+				data[k] = v
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				data[v.Field] = v.Description
+			}
+		}
+	}
+	switch st.Code() {
+	case codes.NotFound, codes.InvalidArgument:
+		if rerr.Code == codeInternalError {
+			rerr.Code = codeInvalidParams
+		}
+	case codes.Unimplemented:
+		rerr.Code = codeMethodNotFound
+	}
+	if len(data) > 0 {
+		rerr.Data = data
+	}
+	return rerr
+}
+
+// decode unmarshals params into v, treating absent params as empty.
+func decode(params json.RawMessage, v any) error {
+	if len(params) == 0 || string(params) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return invalidParams(err)
+	}
+	return nil
+}
+
+type initializeParams struct {
+	ProtocolVersion string                     `json:"protocolVersion"`
+	Capabilities    map[string]json.RawMessage `json:"capabilities"`
+	ClientInfo      struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"clientInfo"`
+}
+
+type initializeResult struct {
+	ProtocolVersion string            `json:"protocolVersion"`
+	Capabilities    map[string]any    `json:"capabilities"`
+	ServerInfo      map[string]string `json:"serverInfo"`
+}
+
+func (b *Bridge) initialize(ctx context.Context, params json.RawMessage) (any, error) {
+	var p initializeParams
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	resp, err := b.client.Initialize(ctx, &mcpv2.InitializeRequest{
+		ProtocolVersion: p.ProtocolVersion,
+		Capabilities: &mcpv2.ClientCapabilities{
+			Roots:    hasCapability(p.Capabilities, "roots"),
+			Sampling: hasCapability(p.Capabilities, "sampling"),
+		},
+		ClientInfo: &mcpv2.ClientInfo{
+			Name:    p.ClientInfo.Name,
+			Version: p.ClientInfo.Version,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// MCP capabilities are objects whose presence enables the feature.
+	caps := make(map[string]any)
+	if c := resp.GetCapabilities(); c != nil {
+		for name, on := range map[string]bool{
+			"logging":   c.Logging,
+			"prompts":   c.Prompts,
+			"resources": c.Resources,
+			"tools":     c.Tools,
+		} {
+			if on {
+				caps[name] = struct{}{}
+			}
+		}
+	}
+	return initializeResult{
+		ProtocolVersion: resp.ProtocolVersion,
+		Capabilities:    caps,
+		ServerInfo: map[string]string{
+			"name":    resp.GetServerInfo().GetName(),
+			"version": resp.GetServerInfo().GetVersion(),
+		},
+	}, nil
+}
+
+// hasCapability reports whether the client declared the named capability.
+// Older clients send booleans; current ones send objects.
+func hasCapability(caps map[string]json.RawMessage, name string) bool {
+	v, ok := caps[name]
+	return ok && string(v) != "false" && string(v) != "null"
+}
+
+func (b *Bridge) ping(ctx context.Context, params json.RawMessage) (any, error) {
+	return struct{}{}, nil
+}
+
+type listParams struct {
+	Cursor string `json:"cursor,omitempty"`
+}
+
+type tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	InputSchema map[string]any `json:"inputSchema"`
+}
+
+func (b *Bridge) listTools(ctx context.Context, params json.RawMessage) (any, error) {
+	var p listParams
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	resp, err := b.client.ListTools(ctx, &mcpv2.ListToolsRequest{Cursor: p.Cursor})
+	if err != nil {
+		return nil, err
+	}
+	tools := []tool{}
+	for _, t := range resp.Tools {
+		schema := t.InputSchema.AsMap()
+		if _, ok := schema["type"]; !ok {
+			schema["type"] = "object"
+		}
+		tools = append(tools, tool{
+			Name:        t.Name,
+			Description: t.Description,
+			InputSchema: schema,
+		})
+	}
+	return struct {
+		Tools      []tool `json:"tools"`
+		NextCursor string `json:"nextCursor,omitempty"`
+	}{tools, resp.NextCursor}, nil
+}
+
+type callToolParams struct {
+	Name      string           `json:"name"`
+	Arguments *structpb.Struct `json:"arguments,omitempty"`
+}
+
+type content struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// dataContent is image or audio content.
+type dataContent struct {
+	Type     string `json:"type"`
+	Data     []byte `json:"data"`
+	MIMEType string `json:"mimeType"`
+}
+
+type embeddedResource struct {
+	Type     string `json:"type"`
+	Resource any    `json:"resource"`
+}
+
+type resourceLink struct {
+	Type string `json:"type"`
+	resource
+}
+
+// toolContent converts a tool result to MCP content.
+func toolContent(c *mcpv2.ToolResult) any {
+	switch c.Type {
+	case "image", "audio":
+		return dataContent{Type: c.Type, Data: c.Data, MIMEType: c.MimeType}
+	case "resource":
+		return embeddedResource{Type: c.Type, Resource: toResourceContents(c.Resource)}
+	case "resource_link":
+		return resourceLink{Type: c.Type, resource: toResource(c.ResourceLink)}
+	default:
+		return content{Type: c.Type, Text: c.Text}
+	}
+}
+
+func (b *Bridge) callTool(ctx context.Context, params json.RawMessage) (any, error) {
+	var p callToolParams
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	if p.Name == "" {
+		return nil, invalidParams(errors.New("missing tool name"))
+	}
+	resp, err := b.client.CallTool(ctx, &mcpv2.CallToolRequest{Name: p.Name, Arguments: p.Arguments})
+	if err != nil {
+		return nil, err
+	}
+	out := []any{}
+	for _, c := range resp.Content {
+		out = append(out, toolContent(c))
+	}
+	var structured map[string]any
+	if resp.StructuredContent != nil {
+		structured = resp.StructuredContent.AsMap()
+	}
+	return struct {
+		Content           []any          `json:"content"`
+		StructuredContent map[string]any `json:"structuredContent,omitempty"`
+		IsError           bool           `json:"isError,omitempty"`
+	}{out, structured, resp.IsError}, nil
+}
+
+type prompt struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Arguments   []promptArgument `json:"arguments,omitempty"`
+}
+
+type promptArgument struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+func (b *Bridge) listPrompts(ctx context.Context, params json.RawMessage) (any, error) {
+	var p listParams
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	resp, err := b.client.ListPrompts(ctx, &mcpv2.ListPromptsRequest{Cursor: p.Cursor})
+	if err != nil {
+		return nil, err
+	}
+	prompts := []prompt{}
+	for _, pr := range resp.Prompts {
+		out := prompt{Name: pr.Name, Description: pr.Description}
+		for _, a := range pr.Arguments {
+			out.Arguments = append(out.Arguments, promptArgument{Name: a.Name, Description: a.Description, Required: a.Required})
+		}
+		prompts = append(prompts, out)
+	}
+	return struct {
+		Prompts    []prompt `json:"prompts"`
+		NextCursor string   `json:"nextCursor,omitempty"`
+	}{prompts, resp.NextCursor}, nil
+}
+
+type getPromptParams struct {
+	Name      string            `json:"name"`
+	Arguments map[string]string `json:"arguments,omitempty"`
+}
+
+type promptMessage struct {
+	Role    string  `json:"role"`
+	Content content `json:"content"`
+}
+
+func (b *Bridge) getPrompt(ctx context.Context, params json.RawMessage) (any, error) {
+	var p getPromptParams
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	if p.Name == "" {
+		return nil, invalidParams(errors.New("missing prompt name"))
+	}
+	resp, err := b.client.GetPrompt(ctx, &mcpv2.GetPromptRequest{Name: p.Name, Arguments: p.Arguments})
+	if err != nil {
+		return nil, err
+	}
+	messages := []promptMessage{}
+	for _, m := range resp.Messages {
+		messages = append(messages, promptMessage{Role: m.Role, Content: content{Type: "text", Text: m.Content}})
+	}
+	return struct {
+		Description string          `json:"description,omitempty"`
+		Messages    []promptMessage `json:"messages"`
+	}{resp.Description, messages}, nil
+}
+
+type resource struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MIMEType    string `json:"mimeType,omitempty"`
+}
+
+func (b *Bridge) listResources(ctx context.Context, params json.RawMessage) (any, error) {
+	var p listParams
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	resp, err := b.client.ListResources(ctx, &mcpv2.ListResourcesRequest{Cursor: p.Cursor})
+	if err != nil {
+		return nil, err
+	}
+	resources := []resource{}
+	for _, r := range resp.Resources {
+		resources = append(resources, toResource(r))
+	}
+	return struct {
+		Resources  []resource `json:"resources"`
+		NextCursor string     `json:"nextCursor,omitempty"`
+	}{resources, resp.NextCursor}, nil
+}
+
+// toResource converts a resource description to MCP.
+func toResource(r *mcpv2.Resource) resource {
+	return resource{URI: r.GetUri(), Name: r.GetName(), Description: r.GetDescription(), MIMEType: r.GetMimeType()}
+}
+
+type resourceContents struct {
+	URI      string `json:"uri"`
+	MIMEType string `json:"mimeType,omitempty"`
+	Text     string `json:"text"`
+}
+
+type blobResourceContents struct {
+	URI      string `json:"uri"`
+	MIMEType string `json:"mimeType,omitempty"`
+	Blob     []byte `json:"blob"`
+}
+
+// toResourceContents converts resource contents to MCP, as text or, if the
+// contents are binary, as a base64 blob.
+func toResourceContents(c *mcpv2.ResourceContent) any {
+	if c.GetBlob() != nil {
+		return blobResourceContents{URI: c.GetUri(), MIMEType: c.GetMimeType(), Blob: c.GetBlob()}
+	}
+	return resourceContents{URI: c.GetUri(), MIMEType: c.GetMimeType(), Text: c.GetText()}
+}
+
+func (b *Bridge) readResource(ctx context.Context, params json.RawMessage) (any, error) {
+	var p struct {
+		URI string `json:"uri"`
+	}
+	if err := decode(params, &p); err != nil {
+		return nil, err
+	}
+	if p.URI == "" {
+		return nil, invalidParams(errors.New("missing resource URI"))
+	}
+	resp, err := b.client.ReadResource(ctx, &mcpv2.ReadResourceRequest{Uri: p.URI})
+	if err != nil {
+		return nil, err
+	}
+	contents := []any{}
+	for _, c := range resp.Contents {
+		contents = append(contents, toResourceContents(c))
+	}
+	return struct {
+		Contents []any `json:"contents"`
+	}{contents}, nil
+}
@@ -0,0 +1,221 @@
+package bridge
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/example/mcp-testing/internal/server"
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
+)
+
+// testResponse is a response as read back from the bridge.
+type testResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// startBridge serves srv through a bridge over pipes, returning functions
+// sending a line to it and reading back the next response.
+func startBridge(t *testing.T, srv mcpv2.MCPServiceServer) (send func(string), recv func() testResponse) {
+	t.Helper()
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		done <- New(InProcess(srv)).Serve(context.Background(), inR, outW)
+		outW.Close()
+	}()
+	t.Cleanup(func() {
+		inW.Close()
+		if err := <-done; err != nil {
+			t.Errorf("Serve: %v", err)
+		}
+	})
+
+	out := bufio.NewScanner(outR)
+	out.Buffer(nil, 1<<20)
+	send = func(line string) {
+		t.Helper()
+		if _, err := io.WriteString(inW, line+"\n"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	recv = func() testResponse {
+		t.Helper()
+		if !out.Scan() {
+			t.Fatalf("no response: %v", out.Err())
+		}
+		var resp testResponse
+		if err := json.Unmarshal(out.Bytes(), &resp); err != nil {
+			t.Fatalf("bad response %s: %v", out.Bytes(), err)
+		}
+		return resp
+	}
+	return send, recv
+}
+
+func TestServe(t *testing.T) {
+	reference, err := server.NewService(t.Context(), "reference", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unimplemented := &mcpv2.UnimplementedMCPServiceServer{}
+
+	tests := []struct {
+		name string
+		srv  mcpv2.MCPServiceServer
+		req  string
+		id   string
+		// Either the error code, or a string the result must contain.
+		code   int
+		result string
+	}{
+		{
+			name:   "initialize",
+			req:    `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{"roots":{}},"clientInfo":{"name":"test","version":"1"}}}`,
+			id:     "1",
+			result: `"serverInfo":{"name":"MCP Reference Server"`,
+		},
+		{
+			name:   "ping",
+			req:    `{"jsonrpc":"2.0","id":"p","method":"ping"}`,
+			id:     `"p"`,
+			result: `{}`,
+		},
+		{
+			name:   "tool call",
+			req:    `{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"add","arguments":{"a":2,"b":3.5}}}`,
+			id:     "2",
+			result: `"text":"Result: 5.5"`,
+		},
+		{
+			name: "parse error",
+			req:  `{"jsonrpc":`,
+			id:   "null",
+			code: codeParseError,
+		},
+		{
+			name: "invalid request",
+			req:  `{"id":3,"method":"ping"}`,
+			id:   "3",
+			code: codeInvalidRequest,
+		},
+		{
+			name: "unknown method",
+			req:  `{"jsonrpc":"2.0","id":4,"method":"sampling/createMessage"}`,
+			id:   "4",
+			code: codeMethodNotFound,
+		},
+		{
+			name: "undecodable params",
+			req:  `{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":7}}`,
+			id:   "5",
+			code: codeInvalidParams,
+		},
+		{
+			name: "unknown resource",
+			req:  `{"jsonrpc":"2.0","id":6,"method":"resources/read","params":{"uri":"file://nope"}}`,
+			id:   "6",
+			code: codeResourceNotFound,
+		},
+		{
+			name: "unknown tool",
+			req:  `{"jsonrpc":"2.0","id":7,"method":"tools/call","params":{"name":"nope"}}`,
+			id:   "7",
+			code: codeInvalidParams,
+		},
+		{
+			name: "invalid tool arguments",
+			req:  `{"jsonrpc":"2.0","id":8,"method":"tools/call","params":{"name":"echo","arguments":{"text":1}}}`,
+			id:   "8",
+			code: codeInvalidParams,
+		},
+		{
+			name: "bad cursor",
+			req:  `{"jsonrpc":"2.0","id":9,"method":"prompts/list","params":{"cursor":"!"}}`,
+			id:   "9",
+			code: codeInvalidParams,
+		},
+		{
+			name: "unimplemented RPC",
+			srv:  unimplemented,
+			req:  `{"jsonrpc":"2.0","id":10,"method":"tools/list"}`,
+			id:   "10",
+			code: codeMethodNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := tt.srv
+			if srv == nil {
+				srv = reference
+			}
+			send, recv := startBridge(t, srv)
+			send(tt.req)
+			resp := recv()
+			if string(resp.ID) != tt.id {
+				t.Errorf("response id = %s, want %s", resp.ID, tt.id)
+			}
+			switch {
+			case tt.code != 0 && resp.Error == nil:
+				t.Errorf("got result %s, want error %d", resp.Result, tt.code)
+			case tt.code != 0 && resp.Error.Code != tt.code:
+				t.Errorf("got error %d %q, want %d", resp.Error.Code, resp.Error.Message, tt.code)
+			case tt.code == 0 && resp.Error != nil:
+				t.Errorf("got error %d %q, want a result", resp.Error.Code, resp.Error.Message)
+			case tt.code == 0 && !strings.Contains(string(resp.Result), tt.result):
+				t.Errorf("result %s does not contain %s", resp.Result, tt.result)
+			}
+		})
+	}
+}
+
+func TestServeErrorData(t *testing.T) {
+	srv, err := server.NewService(t.Context(), "reference", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send, recv := startBridge(t, srv)
+
+	errorData := func() map[string]any {
+		t.Helper()
+		resp := recv()
+		if resp.Error == nil {
+			t.Fatalf("got result %s, want an error", resp.Result)
+		}
+		data, _ := resp.Error.Data.(map[string]any)
+		return data
+	}
+
+	send(`{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":"file://nope"}}`)
+	if data := errorData(); data["uri"] != "file://nope" || data["reason"] != server.ReasonResourceNotFound {
+		t.Errorf("unknown resource error data = %v, want its URI and reason", data)
+	}
+	send(`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"echo","arguments":{}}}`)
+	if data := errorData(); data["arguments.text"] == nil {
+		t.Errorf("invalid argument error data = %v, want the field", data)
+	}
+}
+
+func TestServeNotifications(t *testing.T) {
+	srv, err := server.NewService(t.Context(), "reference", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send, recv := startBridge(t, srv)
+
+	// Notifications, even unknown ones, get no response, so the first
+	// response is the ping's.
+	send(`{"jsonrpc":"2.0","method":"notifications/initialized"}`)
+	send(`{"jsonrpc":"2.0","method":"notifications/nope","params":{}}`)
+	send(``)
+	send(`{"jsonrpc":"2.0","id":42,"method":"ping"}`)
+	if resp := recv(); string(resp.ID) != "42" {
+		t.Errorf("first response is for id %s, want 42", resp.ID)
+	}
+}
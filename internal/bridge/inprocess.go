@@ -0,0 +1,54 @@
+package bridge
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
+)
+
+// InProcess returns a client that calls srv directly, without going through
+// a gRPC connection. Call options are ignored, and streaming calls, which
+// the bridge does not make, are not supported.
+func InProcess(srv mcpv2.MCPServiceServer) mcpv2.MCPServiceClient {
+	return inProcessClient{srv}
+}
+
+type inProcessClient struct {
+	srv mcpv2.MCPServiceServer
+}
+
+func (c inProcessClient) Initialize(ctx context.Context, in *mcpv2.InitializeRequest, _ ...grpc.CallOption) (*mcpv2.InitializeResponse, error) {
+	return c.srv.Initialize(ctx, in)
+}
+
+func (c inProcessClient) ListTools(ctx context.Context, in *mcpv2.ListToolsRequest, _ ...grpc.CallOption) (*mcpv2.ListToolsResponse, error) {
+	return c.srv.ListTools(ctx, in)
+}
+
+func (c inProcessClient) CallTool(ctx context.Context, in *mcpv2.CallToolRequest, _ ...grpc.CallOption) (*mcpv2.CallToolResponse, error) {
+	return c.srv.CallTool(ctx, in)
+}
+
+func (c inProcessClient) ListPrompts(ctx context.Context, in *mcpv2.ListPromptsRequest, _ ...grpc.CallOption) (*mcpv2.ListPromptsResponse, error) {
+	return c.srv.ListPrompts(ctx, in)
+}
+
+func (c inProcessClient) GetPrompt(ctx context.Context, in *mcpv2.GetPromptRequest, _ ...grpc.CallOption) (*mcpv2.GetPromptResponse, error) {
+	return c.srv.GetPrompt(ctx, in)
+}
+
+func (c inProcessClient) ListResources(ctx context.Context, in *mcpv2.ListResourcesRequest, _ ...grpc.CallOption) (*mcpv2.ListResourcesResponse, error) {
+	return c.srv.ListResources(ctx, in)
+}
+
+func (c inProcessClient) ReadResource(ctx context.Context, in *mcpv2.ReadResourceRequest, _ ...grpc.CallOption) (*mcpv2.ReadResourceResponse, error) {
+	return c.srv.ReadResource(ctx, in)
+}
+
+func (c inProcessClient) StreamQuotes(ctx context.Context, in *mcpv2.StreamQuotesRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[mcpv2.StreamedQuote], error) {
+	return nil, status.Error(codes.Unimplemented, "streaming calls are not supported in-process")
+}
@@ -0,0 +1,159 @@
+package bridge
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp"
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
+)
+
+// FromV1 returns a v2 client calling c, a client of a server that only
+// serves the v1 MCPService, whose tool arguments and input schemas are maps
+// of strings. It mirrors server.V1.
+//
+// Tool arguments are sent the v1 way: string values as they are and
+// anything else as JSON. Schema values are parsed as JSON, falling back to
+// the plain string if they do not parse. Responses lose the fields v1 does
+// not have, such as structured tool output.
+func FromV1(c mcp.MCPServiceClient) mcpv2.MCPServiceClient {
+	return v1Client{c}
+}
+
+type v1Client struct {
+	c mcp.MCPServiceClient
+}
+
+// convert copies from into to, which must be wire-compatible messages of the
+// two versions. Fields only known to from are dropped.
+func convert(from, to proto.Message) error {
+	data, err := proto.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, to)
+}
+
+// call converts req, passes it to f and converts the response back.
+func call[Req2, Resp2, Req1, Resp1 proto.Message](ctx context.Context, req Req2, req1 Req1, resp Resp2, f func(context.Context, Req1, ...grpc.CallOption) (Resp1, error), opts []grpc.CallOption) (Resp2, error) {
+	var zero Resp2
+	if err := convert(req, req1); err != nil {
+		return zero, err
+	}
+	resp1, err := f(ctx, req1, opts...)
+	if err != nil {
+		return zero, err
+	}
+	if err := convert(resp1, resp); err != nil {
+		return zero, err
+	}
+	return resp, nil
+}
+
+func (v v1Client) Initialize(ctx context.Context, in *mcpv2.InitializeRequest, opts ...grpc.CallOption) (*mcpv2.InitializeResponse, error) {
+	return call(ctx, in, &mcp.InitializeRequest{}, &mcpv2.InitializeResponse{}, v.c.Initialize, opts)
+}
+
+func (v v1Client) ListTools(ctx context.Context, in *mcpv2.ListToolsRequest, opts ...grpc.CallOption) (*mcpv2.ListToolsResponse, error) {
+	resp, err := v.c.ListTools(ctx, &mcp.ListToolsRequest{Cursor: in.Cursor, PageSize: in.PageSize}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	tools := make([]*mcpv2.Tool, len(resp.Tools))
+	for i, t := range resp.Tools {
+		tools[i] = &mcpv2.Tool{
+			Name:        t.Name,
+			Description: t.Description,
+			InputSchema: v2Schema(t.InputSchema),
+		}
+	}
+	return &mcpv2.ListToolsResponse{
+		Tools:      tools,
+		NextCursor: resp.NextCursor,
+	}, nil
+}
+
+// v2Schema rebuilds a schema flattened into a map of strings.
+func v2Schema(schema map[string]string) *structpb.Struct {
+	s := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(schema))}
+	for name, value := range schema {
+		v := &structpb.Value{}
+		if v.UnmarshalJSON([]byte(value)) != nil {
+			v = structpb.NewStringValue(value)
+		}
+		s.Fields[name] = v
+	}
+	return s
+}
+
+func (v v1Client) CallTool(ctx context.Context, in *mcpv2.CallToolRequest, opts ...grpc.CallOption) (*mcpv2.CallToolResponse, error) {
+	args := make(map[string]string, len(in.Arguments.GetFields()))
+	for name, value := range in.Arguments.GetFields() {
+		if s, ok := value.GetKind().(*structpb.Value_StringValue); ok {
+			args[name] = s.StringValue
+			continue
+		}
+		data, err := value.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		args[name] = string(data)
+	}
+	resp, err := v.c.CallTool(ctx, &mcp.CallToolRequest{Name: in.Name, Arguments: args}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp2 := &mcpv2.CallToolResponse{}
+	if err := convert(resp, resp2); err != nil {
+		return nil, err
+	}
+	return resp2, nil
+}
+
+func (v v1Client) ListPrompts(ctx context.Context, in *mcpv2.ListPromptsRequest, opts ...grpc.CallOption) (*mcpv2.ListPromptsResponse, error) {
+	return call(ctx, in, &mcp.ListPromptsRequest{}, &mcpv2.ListPromptsResponse{}, v.c.ListPrompts, opts)
+}
+
+func (v v1Client) GetPrompt(ctx context.Context, in *mcpv2.GetPromptRequest, opts ...grpc.CallOption) (*mcpv2.GetPromptResponse, error) {
+	return call(ctx, in, &mcp.GetPromptRequest{}, &mcpv2.GetPromptResponse{}, v.c.GetPrompt, opts)
+}
+
+func (v v1Client) ListResources(ctx context.Context, in *mcpv2.ListResourcesRequest, opts ...grpc.CallOption) (*mcpv2.ListResourcesResponse, error) {
+	return call(ctx, in, &mcp.ListResourcesRequest{}, &mcpv2.ListResourcesResponse{}, v.c.ListResources, opts)
+}
+
+func (v v1Client) ReadResource(ctx context.Context, in *mcpv2.ReadResourceRequest, opts ...grpc.CallOption) (*mcpv2.ReadResourceResponse, error) {
+	return call(ctx, in, &mcp.ReadResourceRequest{}, &mcpv2.ReadResourceResponse{}, v.c.ReadResource, opts)
+}
+
+func (v v1Client) StreamQuotes(ctx context.Context, in *mcpv2.StreamQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[mcpv2.StreamedQuote], error) {
+	in1 := &mcp.StreamQuotesRequest{}
+	if err := convert(in, in1); err != nil {
+		return nil, err
+	}
+	stream, err := v.c.StreamQuotes(ctx, in1, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return v1QuoteStream{stream}, nil
+}
+
+// v1QuoteStream receives v2 quotes from a v1 stream.
+type v1QuoteStream struct {
+	grpc.ServerStreamingClient[mcp.StreamedQuote]
+}
+
+func (s v1QuoteStream) Recv() (*mcpv2.StreamedQuote, error) {
+	quote, err := s.ServerStreamingClient.Recv()
+	if err != nil {
+		return nil, err
+	}
+	quote2 := &mcpv2.StreamedQuote{}
+	if err := convert(quote, quote2); err != nil {
+		return nil, err
+	}
+	return quote2, nil
+}
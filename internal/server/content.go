@@ -0,0 +1,59 @@
+package server
+
+import (
+	"strings"
+
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
+)
+
+// TextContent returns a text tool result.
+func TextContent(text string) *mcpv2.ToolResult {
+	return &mcpv2.ToolResult{Type: "text", Text: text}
+}
+
+// ImageContent returns an image tool result of the given MIME type, e.g.
+// image/png.
+func ImageContent(data []byte, mimeType string) *mcpv2.ToolResult {
+	return &mcpv2.ToolResult{Type: "image", Data: data, MimeType: mimeType}
+}
+
+// AudioContent returns an audio tool result of the given MIME type, e.g.
+// audio/wav.
+func AudioContent(data []byte, mimeType string) *mcpv2.ToolResult {
+	return &mcpv2.ToolResult{Type: "audio", Data: data, MimeType: mimeType}
+}
+
+// EmbeddedResource returns a tool result carrying the contents of a
+// resource.
+func EmbeddedResource(contents *mcpv2.ResourceContent) *mcpv2.ToolResult {
+	return &mcpv2.ToolResult{Type: "resource", Resource: contents}
+}
+
+// ResourceLink returns a tool result pointing to a resource that the client
+// may read.
+func ResourceLink(resource *mcpv2.Resource) *mcpv2.ToolResult {
+	return &mcpv2.ToolResult{Type: "resource_link", ResourceLink: resource}
+}
+
+// resourceContent returns data as the contents of the resource at uri,
+// as text for textual MIME types and as a blob otherwise.
+func resourceContent(uri, mimeType string, data []byte) *mcpv2.ResourceContent {
+	c := &mcpv2.ResourceContent{Uri: uri, MimeType: mimeType}
+	if isText(mimeType) {
+		c.Text = string(data)
+	} else {
+		c.Blob = data
+	}
+	return c
+}
+
+// isText reports whether contents of the given MIME type are text.
+func isText(mimeType string) bool {
+	switch {
+	case strings.HasPrefix(mimeType, "text/"),
+		mimeType == "application/json",
+		strings.HasSuffix(mimeType, "+json"):
+		return true
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo details attached to
+// NotFound errors.
+const ErrorDomain = "mcp"
+
+// ErrorInfo reasons of NotFound errors, naming what was not found. The
+// metadata holds the tool or prompt "name", or the resource "uri".
+const (
+	ReasonToolNotFound     = "TOOL_NOT_FOUND"
+	ReasonPromptNotFound   = "PROMPT_NOT_FOUND"
+	ReasonResourceNotFound = "RESOURCE_NOT_FOUND"
+)
+
+// toolNotFound returns the error for a call to an unknown tool.
+func toolNotFound(name string) error {
+	return notFound(ReasonToolNotFound, fmt.Sprintf("unknown tool '%s'", name), "name", name)
+}
+
+// promptNotFound returns the error for a request for an unknown prompt.
+func promptNotFound(name string) error {
+	return notFound(ReasonPromptNotFound, fmt.Sprintf("unknown prompt '%s'", name), "name", name)
+}
+
+// resourceNotFound returns the error for a read of an unknown resource.
+func resourceNotFound(uri string) error {
+	return notFound(ReasonResourceNotFound, fmt.Sprintf("resource not found: %s", uri), "uri", uri)
+}
+
+func notFound(reason, msg, key, value string) error {
+	st, err := status.New(codes.NotFound, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: map[string]string{key: value},
+	})
+	if err != nil {
+		return status.Error(codes.NotFound, msg)
+	}
+	return st.Err()
+}
+
+// invalidArgument returns an InvalidArgument error with message msg, with a
+// google.rpc.BadRequest detail naming the offending request fields, such as
+// "cursor" or "arguments.topic".
+func invalidArgument(msg string, fields ...string) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(fields))
+	for i, field := range fields {
+		violations[i] = &errdetails.BadRequest_FieldViolation{Field: field, Description: msg}
+	}
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(&errdetails.BadRequest{
+		FieldViolations: violations,
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return st.Err()
+}
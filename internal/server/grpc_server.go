@@ -3,113 +3,127 @@ package server
 import (
 	"context"
 	"log"
+	"strconv"
 
-	"github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
 )
 
+// MCPServer is the reference implementation of the v2 MCPService, with demo
+// tools, a prompt and resources. Serve it to v1 clients through V1.
 type MCPServer struct {
-	mcp.UnimplementedMCPServiceServer
+	mcpv2.UnimplementedMCPServiceServer
 }
 
 func NewMCPServer() *MCPServer {
 	return &MCPServer{}
 }
 
-func (s *MCPServer) Initialize(ctx context.Context, req *mcp.InitializeRequest) (*mcp.InitializeResponse, error) {
+func (s *MCPServer) Initialize(ctx context.Context, req *mcpv2.InitializeRequest) (*mcpv2.InitializeResponse, error) {
 	log.Printf("Initialize called with protocol version: %s", req.ProtocolVersion)
 	
-	return &mcp.InitializeResponse{
+	return &mcpv2.InitializeResponse{
 		ProtocolVersion: "2024-11-05",
-		Capabilities: &mcp.ServerCapabilities{
+		Capabilities: &mcpv2.ServerCapabilities{
 			Logging:   true,
 			Prompts:   true,
 			Resources: true,
 			Tools:     true,
 		},
-		ServerInfo: &mcp.ServerInfo{
+		ServerInfo: &mcpv2.ServerInfo{
 			Name:    "MCP Reference Server",
 			Version: "1.0.0",
 		},
 	}, nil
 }
 
-func (s *MCPServer) ListTools(ctx context.Context, req *mcp.ListToolsRequest) (*mcp.ListToolsResponse, error) {
+func (s *MCPServer) ListTools(ctx context.Context, req *mcpv2.ListToolsRequest) (*mcpv2.ListToolsResponse, error) {
 	log.Printf("ListTools called")
 	
-	tools := []*mcp.Tool{
+	tools := []*mcpv2.Tool{
 		{
 			Name:        "echo",
 			Description: "Echo back the input text",
-			InputSchema: map[string]string{
+			InputSchema: mustStruct(map[string]any{
 				"type": "object",
-				"properties": `{"text": {"type": "string", "description": "Text to echo back"}}`,
-				"required": `["text"]`,
-			},
+				"properties": map[string]any{
+					"text": map[string]any{"type": "string", "description": "Text to echo back"},
+				},
+				"required": []any{"text"},
+			}),
 		},
 		{
 			Name:        "add",
 			Description: "Add two numbers together",
-			InputSchema: map[string]string{
+			InputSchema: mustStruct(map[string]any{
 				"type": "object",
-				"properties": `{"a": {"type": "number"}, "b": {"type": "number"}}`,
-				"required": `["a", "b"]`,
-			},
+				"properties": map[string]any{
+					"a": map[string]any{"type": "number"},
+					"b": map[string]any{"type": "number"},
+				},
+				"required": []any{"a", "b"},
+			}),
+		},
+		{
+			Name:        "sample_media",
+			Description: "Return one sample of each rich content type: an image, an audio clip, an embedded resource and a resource link",
+			InputSchema: mustStruct(map[string]any{
+				"type":       "object",
+				"properties": map[string]any{},
+			}),
 		},
 	}
 	
-	return &mcp.ListToolsResponse{
-		Tools: tools,
+	page, next, err := paginate(tools, (*mcpv2.Tool).GetName, req.Cursor, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	
+	return &mcpv2.ListToolsResponse{
+		Tools:      page,
+		NextCursor: next,
 	}, nil
 }
 
-func (s *MCPServer) CallTool(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResponse, error) {
+func (s *MCPServer) CallTool(ctx context.Context, req *mcpv2.CallToolRequest) (*mcpv2.CallToolResponse, error) {
 	log.Printf("CallTool called with name: %s", req.Name)
 	
 	switch req.Name {
 	case "echo":
-		text, ok := req.Arguments["text"]
+		text, ok := req.Arguments.GetFields()["text"].GetKind().(*structpb.Value_StringValue)
 		if !ok {
-			return &mcp.CallToolResponse{
-				Content: []*mcp.ToolResult{
-					{
-						Type: "text",
-						Text: "Error: missing 'text' argument",
-					},
-				},
-				IsError: true,
-			}, nil
+			return nil, invalidArgument("missing or non-string 'text' argument", "arguments.text")
 		}
 		
-		return &mcp.CallToolResponse{
-			Content: []*mcp.ToolResult{
+		return &mcpv2.CallToolResponse{
+			Content: []*mcpv2.ToolResult{
 				{
 					Type: "text",
-					Text: text,
+					Text: text.StringValue,
 				},
 			},
 			IsError: false,
 		}, nil
 		
 	case "add":
-		aStr, aOk := req.Arguments["a"]
-		bStr, bOk := req.Arguments["b"]
+		a, aOk := req.Arguments.GetFields()["a"].GetKind().(*structpb.Value_NumberValue)
+		b, bOk := req.Arguments.GetFields()["b"].GetKind().(*structpb.Value_NumberValue)
 		if !aOk || !bOk {
-			return &mcp.CallToolResponse{
-				Content: []*mcp.ToolResult{
-					{
-						Type: "text",
-						Text: "Error: missing 'a' or 'b' argument",
-					},
-				},
-				IsError: true,
-			}, nil
+			var fields []string
+			if !aOk {
+				fields = append(fields, "arguments.a")
+			}
+			if !bOk {
+				fields = append(fields, "arguments.b")
+			}
+			return nil, invalidArgument("missing or non-numeric 'a' or 'b' argument", fields...)
 		}
 		
-		// Simple string concatenation for demo - in real implementation would parse numbers
-		result := "Result: " + aStr + " + " + bStr
+		result := "Result: " + strconv.FormatFloat(a.NumberValue+b.NumberValue, 'g', -1, 64)
 		
-		return &mcp.CallToolResponse{
-			Content: []*mcp.ToolResult{
+		return &mcpv2.CallToolResponse{
+			Content: []*mcpv2.ToolResult{
 				{
 					Type: "text",
 					Text: result,
@@ -118,27 +132,38 @@ func (s *MCPServer) CallTool(ctx context.Context, req *mcp.CallToolRequest) (*mc
 			IsError: false,
 		}, nil
 		
-	default:
-		return &mcp.CallToolResponse{
-			Content: []*mcp.ToolResult{
-				{
-					Type: "text",
-					Text: "Error: unknown tool '" + req.Name + "'",
-				},
+	case "sample_media":
+		return &mcpv2.CallToolResponse{
+			Content: []*mcpv2.ToolResult{
+				ImageContent(logoPNG(), "image/png"),
+				AudioContent(beepWAV(), "audio/wav"),
+				EmbeddedResource(&mcpv2.ResourceContent{
+					Uri:      "file://config.json",
+					MimeType: "application/json",
+					Text:     `{"name": "mcp-server", "version": "1.0.0", "debug": true}`,
+				}),
+				ResourceLink(&mcpv2.Resource{
+					Uri:         "file://logo.png",
+					Name:        "Logo",
+					Description: "Project logo",
+					MimeType:    "image/png",
+				}),
 			},
-			IsError: true,
 		}, nil
+		
+	default:
+		return nil, toolNotFound(req.Name)
 	}
 }
 
-func (s *MCPServer) ListPrompts(ctx context.Context, req *mcp.ListPromptsRequest) (*mcp.ListPromptsResponse, error) {
+func (s *MCPServer) ListPrompts(ctx context.Context, req *mcpv2.ListPromptsRequest) (*mcpv2.ListPromptsResponse, error) {
 	log.Printf("ListPrompts called")
 	
-	prompts := []*mcp.Prompt{
+	prompts := []*mcpv2.Prompt{
 		{
 			Name:        "greeting",
 			Description: "Generate a greeting message",
-			Arguments: []*mcp.PromptArgument{
+			Arguments: []*mcpv2.PromptArgument{
 				{
 					Name:        "name",
 					Description: "Name of the person to greet",
@@ -148,12 +173,18 @@ func (s *MCPServer) ListPrompts(ctx context.Context, req *mcp.ListPromptsRequest
 		},
 	}
 	
-	return &mcp.ListPromptsResponse{
-		Prompts: prompts,
+	page, next, err := paginate(prompts, (*mcpv2.Prompt).GetName, req.Cursor, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	
+	return &mcpv2.ListPromptsResponse{
+		Prompts:    page,
+		NextCursor: next,
 	}, nil
 }
 
-func (s *MCPServer) GetPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResponse, error) {
+func (s *MCPServer) GetPrompt(ctx context.Context, req *mcpv2.GetPromptRequest) (*mcpv2.GetPromptResponse, error) {
 	log.Printf("GetPrompt called with name: %s", req.Name)
 	
 	switch req.Name {
@@ -163,9 +194,9 @@ func (s *MCPServer) GetPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*
 			name = "World"
 		}
 		
-		return &mcp.GetPromptResponse{
+		return &mcpv2.GetPromptResponse{
 			Description: "A friendly greeting",
-			Messages: []*mcp.PromptMessage{
+			Messages: []*mcpv2.PromptMessage{
 				{
 					Role:    "user",
 					Content: "Hello, " + name + "! How are you today?",
@@ -174,22 +205,14 @@ func (s *MCPServer) GetPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*
 		}, nil
 		
 	default:
-		return &mcp.GetPromptResponse{
-			Description: "Unknown prompt",
-			Messages: []*mcp.PromptMessage{
-				{
-					Role:    "system",
-					Content: "Error: unknown prompt '" + req.Name + "'",
-				},
-			},
-		}, nil
+		return nil, promptNotFound(req.Name)
 	}
 }
 
-func (s *MCPServer) ListResources(ctx context.Context, req *mcp.ListResourcesRequest) (*mcp.ListResourcesResponse, error) {
+func (s *MCPServer) ListResources(ctx context.Context, req *mcpv2.ListResourcesRequest) (*mcpv2.ListResourcesResponse, error) {
 	log.Printf("ListResources called")
 	
-	resources := []*mcp.Resource{
+	resources := []*mcpv2.Resource{
 		{
 			Uri:         "file://README.md",
 			Name:        "README",
@@ -202,20 +225,32 @@ func (s *MCPServer) ListResources(ctx context.Context, req *mcp.ListResourcesReq
 			Description: "Application configuration",
 			MimeType:    "application/json",
 		},
+		{
+			Uri:         "file://logo.png",
+			Name:        "Logo",
+			Description: "Project logo",
+			MimeType:    "image/png",
+		},
+	}
+	
+	page, next, err := paginate(resources, (*mcpv2.Resource).GetUri, req.Cursor, req.PageSize)
+	if err != nil {
+		return nil, err
 	}
 	
-	return &mcp.ListResourcesResponse{
-		Resources: resources,
+	return &mcpv2.ListResourcesResponse{
+		Resources:  page,
+		NextCursor: next,
 	}, nil
 }
 
-func (s *MCPServer) ReadResource(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResponse, error) {
+func (s *MCPServer) ReadResource(ctx context.Context, req *mcpv2.ReadResourceRequest) (*mcpv2.ReadResourceResponse, error) {
 	log.Printf("ReadResource called with URI: %s", req.Uri)
 	
 	switch req.Uri {
 	case "file://README.md":
-		return &mcp.ReadResourceResponse{
-			Contents: []*mcp.ResourceContent{
+		return &mcpv2.ReadResourceResponse{
+			Contents: []*mcpv2.ResourceContent{
 				{
 					Uri:      req.Uri,
 					MimeType: "text/markdown",
@@ -225,8 +260,8 @@ func (s *MCPServer) ReadResource(ctx context.Context, req *mcp.ReadResourceReque
 		}, nil
 		
 	case "file://config.json":
-		return &mcp.ReadResourceResponse{
-			Contents: []*mcp.ResourceContent{
+		return &mcpv2.ReadResourceResponse{
+			Contents: []*mcpv2.ResourceContent{
 				{
 					Uri:      req.Uri,
 					MimeType: "application/json",
@@ -235,15 +270,18 @@ func (s *MCPServer) ReadResource(ctx context.Context, req *mcp.ReadResourceReque
 			},
 		}, nil
 		
-	default:
-		return &mcp.ReadResourceResponse{
-			Contents: []*mcp.ResourceContent{
+	case "file://logo.png":
+		return &mcpv2.ReadResourceResponse{
+			Contents: []*mcpv2.ResourceContent{
 				{
 					Uri:      req.Uri,
-					MimeType: "text/plain",
-					Text:     "Error: resource not found",
+					MimeType: "image/png",
+					Blob:     logoPNG(),
 				},
 			},
 		}, nil
+		
+	default:
+		return nil, resourceNotFound(req.Uri)
 	}
 }
\ No newline at end of file
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"io/fs"
+	"net"
+	"os"
+	"strings"
+)
+
+// Listen listens on addr, which is either a TCP address such as ":50051" or
+// "localhost:50051", or a Unix socket path prefixed with "unix:" or
+// "unix://", the way gRPC clients dial them. A stale socket left behind by
+// a server that did not shut down cleanly is removed first.
+func Listen(addr string) (net.Listener, error) {
+	path, ok := strings.CutPrefix(addr, "unix:")
+	if !ok {
+		return net.Listen("tcp", addr)
+	}
+	path = strings.TrimPrefix(path, "//")
+	if fi, err := os.Stat(path); err == nil && fi.Mode().Type() == fs.ModeSocket {
+		if conn, err := net.Dial("unix", path); err == nil {
+			conn.Close()
+		} else {
+			os.Remove(path)
+		}
+	}
+	return net.Listen("unix", path)
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+)
+
+// logoPNG draws the reference server's logo: a 64x64 pink disc on a dark
+// background.
+func logoPNG() []byte {
+	const size = 64
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dx, dy := float64(x)-size/2+0.5, float64(y)-size/2+0.5
+			if math.Hypot(dx, dy) < size/2-6 {
+				img.Set(x, y, color.RGBA{0xff, 0x69, 0xb4, 0xff})
+			} else {
+				img.Set(x, y, color.RGBA{0x1d, 0x1b, 0x2f, 0xff})
+			}
+		}
+	}
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		panic(err)
+	}
+	return b.Bytes()
+}
+
+// beepWAV synthesizes a short 440 Hz beep as 8 kHz, 16-bit mono PCM.
+func beepWAV() []byte {
+	const (
+		rate    = 8000
+		samples = rate / 4
+	)
+	pcm := make([]int16, samples)
+	for i := range pcm {
+		pcm[i] = int16(8000 * math.Sin(2*math.Pi*440*float64(i)/rate))
+	}
+	header := struct {
+		RIFF          [4]byte
+		Size          uint32
+		WAVE, Fmt     [4]byte
+		FmtSize       uint32
+		Format        uint16
+		Channels      uint16
+		SampleRate    uint32
+		ByteRate      uint32
+		BlockAlign    uint16
+		BitsPerSample uint16
+		Data          [4]byte
+		DataSize      uint32
+	}{
+		RIFF: [4]byte{'R', 'I', 'F', 'F'}, Size: 36 + samples*2,
+		WAVE: [4]byte{'W', 'A', 'V', 'E'}, Fmt: [4]byte{'f', 'm', 't', ' '},
+		FmtSize: 16, Format: 1, Channels: 1,
+		SampleRate: rate, ByteRate: rate * 2, BlockAlign: 2, BitsPerSample: 16,
+		Data: [4]byte{'d', 'a', 't', 'a'}, DataSize: samples * 2,
+	}
+	var b bytes.Buffer
+	binary.Write(&b, binary.LittleEndian, header)
+	binary.Write(&b, binary.LittleEndian, pcm)
+	return b.Bytes()
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// Page sizes of the list RPCs. Requests may ask for smaller pages, or for
+// larger ones up to MaxPageSize.
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// pageCursor is the decoded form of a list cursor: the key of the last item
+// on the previous page. Items are listed in key order, so pages stay
+// consistent when items are added or removed between calls.
+type pageCursor struct {
+	After string `json:"a"`
+}
+
+func encodeCursor(c pageCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (pageCursor, error) {
+	var c pageCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil {
+		return pageCursor{}, invalidArgument("invalid cursor", "cursor")
+	}
+	return c, nil
+}
+
+// paginate returns the page of items following cursor, with items ordered
+// by key, and the cursor of the next page, which is empty on the last page.
+// Keys must be unique.
+func paginate[T any](items []T, key func(T) string, cursor string, pageSize uint32) ([]T, string, error) {
+	size := DefaultPageSize
+	if pageSize != 0 {
+		size = min(int(pageSize), MaxPageSize)
+	}
+	items = slices.SortedFunc(slices.Values(items), func(a, b T) int {
+		return strings.Compare(key(a), key(b))
+	})
+	if cursor != "" {
+		c, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		i, _ := slices.BinarySearchFunc(items, c.After, func(item T, after string) int {
+			return strings.Compare(key(item), after)
+		})
+		// Resume after the last item seen, even if it is gone.
+		if i < len(items) && key(items[i]) == c.After {
+			i++
+		}
+		items = items[i:]
+	}
+	if len(items) <= size {
+		return items, "", nil
+	}
+	page := items[:size]
+	return page, encodeCursor(pageCursor{After: key(page[size-1])}), nil
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
+	"github.com/example/mcp-testing/pkg/quotify"
+)
+
+func identity(s string) string { return s }
+
+// keys returns n keys that sort in order: k0000, k0001 and so on.
+func keys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("k%04d", i)
+	}
+	return keys
+}
+
+func TestPaginatePageSize(t *testing.T) {
+	items := keys(MaxPageSize + 10)
+	for _, tt := range []struct {
+		pageSize uint32
+		want     int
+	}{
+		{0, DefaultPageSize},
+		{1, 1},
+		{7, 7},
+		{MaxPageSize, MaxPageSize},
+		{MaxPageSize + 1, MaxPageSize},
+	} {
+		page, next, err := paginate(items, identity, "", tt.pageSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) != tt.want || next == "" {
+			t.Errorf("page size %d: got %d items and next cursor %q, want %d and a cursor", tt.pageSize, len(page), next, tt.want)
+		}
+	}
+
+	page, next, err := paginate(keys(3), identity, "", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 3 || next != "" {
+		t.Errorf("last page: got %d items and next cursor %q, want 3 and no cursor", len(page), next)
+	}
+}
+
+func TestPaginateCursor(t *testing.T) {
+	items := keys(10)
+	// Items come back in key order whatever order they are given in.
+	shuffled := slices.Clone(items)
+	slices.Reverse(shuffled)
+
+	page, next, err := paginate(shuffled, identity, "", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(page, items[:4]) {
+		t.Errorf("first page = %v, want %v", page, items[:4])
+	}
+	c, err := decodeCursor(next)
+	if err != nil || c.After != items[3] {
+		t.Errorf("next cursor decodes to %+v, %v, want after %s", c, err, items[3])
+	}
+
+	// A cursor stays valid when the item it points after is removed, or
+	// items are added before it.
+	stale := slices.Concat([]string{"a"}, items[:3], items[4:])
+	page, _, err = paginate(stale, identity, next, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(page, items[4:8]) {
+		t.Errorf("page after a removed item = %v, want %v", page, items[4:8])
+	}
+
+	page, next, err = paginate(items, identity, encodeCursor(pageCursor{After: items[9]}), 4)
+	if err != nil || len(page) != 0 || next != "" {
+		t.Errorf("page after the last item = %v, %q, %v, want an empty last page", page, next, err)
+	}
+}
+
+func TestPaginateInvalidCursor(t *testing.T) {
+	for _, cursor := range []string{
+		"!",
+		"not a cursor",
+		base64.RawURLEncoding.EncodeToString([]byte("not JSON")),
+		base64.RawURLEncoding.EncodeToString([]byte(`{"a":1}`)),
+	} {
+		_, _, err := paginate(keys(3), identity, cursor, 0)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("cursor %q: error = %v, want InvalidArgument", cursor, err)
+		}
+	}
+}
+
+func TestListResourcesPages(t *testing.T) {
+	s := NewQuotifyServer(quotify.NewWithSeed(1))
+	all, err := s.ListResources(t.Context(), &mcpv2.ListResourcesRequest{PageSize: MaxPageSize})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all.NextCursor != "" {
+		t.Fatalf("%d resources do not fit one page", len(all.Resources))
+	}
+
+	var uris []string
+	req := &mcpv2.ListResourcesRequest{PageSize: 7}
+	for pages := 1; ; pages++ {
+		resp, err := s.ListResources(t.Context(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Resources) > 7 {
+			t.Errorf("page %d has %d resources, more than asked for", pages, len(resp.Resources))
+		}
+		for _, r := range resp.Resources {
+			uris = append(uris, r.Uri)
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		if pages > len(all.Resources) {
+			t.Fatal("pages do not end")
+		}
+		req.Cursor = resp.NextCursor
+	}
+
+	// Every resource is listed once, in order: no duplicates, no gaps.
+	var want []string
+	for _, r := range all.Resources {
+		want = append(want, r.Uri)
+	}
+	if !slices.Equal(uris, want) {
+		t.Errorf("paged listing differs from the full one:\n%s\n%s", strings.Join(uris, " "), strings.Join(want, " "))
+	}
+	if !slices.IsSorted(uris) || len(slices.Compact(slices.Clone(uris))) != len(uris) {
+		t.Errorf("paged listing is not sorted and unique: %v", uris)
+	}
+}
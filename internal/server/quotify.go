@@ -0,0 +1,334 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonschema"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
+	"github.com/example/mcp-testing/pkg/quotify"
+)
+
+// QuotifyServer serves the quotify tool, prompts and corpus resources over
+// the v2 MCPService, behaving like the stdio server; serve it to v1 clients
+// through V1. gRPC calls carry no session, so quotes are drawn without a
+// shuffle bag, and the quiz tools, which keep per-session state, are left
+// out. So is quotify_search, for now.
+type QuotifyServer struct {
+	mcpv2.UnimplementedMCPServiceServer
+
+	quotifier atomic.Pointer[quotify.Quotify]
+}
+
+// NewQuotifyServer returns a server generating quotes from q.
+func NewQuotifyServer(q *quotify.Quotify) *QuotifyServer {
+	s := &QuotifyServer{}
+	s.quotifier.Store(q)
+	return s
+}
+
+// SetQuotify swaps in q for all subsequent calls, e.g. after the corpus has
+// been reloaded.
+func (s *QuotifyServer) SetQuotify(q *quotify.Quotify) {
+	s.quotifier.Store(q)
+}
+
+func (s *QuotifyServer) Initialize(ctx context.Context, req *mcpv2.InitializeRequest) (*mcpv2.InitializeResponse, error) {
+	log.Printf("Initialize called with protocol version: %s", req.ProtocolVersion)
+
+	return &mcpv2.InitializeResponse{
+		ProtocolVersion: "2024-11-05",
+		Capabilities: &mcpv2.ServerCapabilities{
+			Prompts:   true,
+			Resources: true,
+			Tools:     true,
+		},
+		ServerInfo: &mcpv2.ServerInfo{
+			Name:    "quotify-server",
+			Version: "1.0.0",
+		},
+	}, nil
+}
+
+func (s *QuotifyServer) ListTools(ctx context.Context, req *mcpv2.ListToolsRequest) (*mcpv2.ListToolsResponse, error) {
+	log.Printf("ListTools called")
+
+	schema, err := quotifyInputSchema()
+	if err != nil {
+		return nil, err
+	}
+	tools := []*mcpv2.Tool{
+		{
+			Name:        "quotify",
+			Description: quotify.ToolDescription,
+			InputSchema: schema,
+		},
+	}
+	page, next, err := paginate(tools, (*mcpv2.Tool).GetName, req.Cursor, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &mcpv2.ListToolsResponse{
+		Tools:      page,
+		NextCursor: next,
+	}, nil
+}
+
+// quotifyInputSchema returns the quotify tool's input schema, with the
+// output formats listed as an enum.
+func quotifyInputSchema() (*structpb.Struct, error) {
+	schema, err := jsonschema.For[quotify.ToolArgs]()
+	if err != nil {
+		return nil, err
+	}
+	var formats []any
+	for _, name := range quotify.ToolFormats() {
+		formats = append(formats, name)
+	}
+	schema.Properties["format"].Enum = formats
+	return toStruct(schema)
+}
+
+func (s *QuotifyServer) CallTool(ctx context.Context, req *mcpv2.CallToolRequest) (*mcpv2.CallToolResponse, error) {
+	log.Printf("CallTool called with name: %s", req.Name)
+
+	if req.Name != "quotify" {
+		return nil, toolNotFound(req.Name)
+	}
+	var args quotify.ToolArgs
+	if err := fromStruct(req.Arguments, &args); err != nil {
+		return nil, invalidArgument(fmt.Sprintf("invalid arguments: %v", err), "arguments")
+	}
+	result, text, err := s.quotifier.Load().RunTool(args, nil)
+	var argErr *quotify.ArgumentError
+	if errors.As(err, &argErr) {
+		return nil, invalidArgument(err.Error(), "arguments."+argErr.Arg)
+	}
+	if err != nil {
+		return toolError(err), nil
+	}
+	structured, err := toStruct(result)
+	if err != nil {
+		return nil, err
+	}
+	content := []*mcpv2.ToolResult{TextContent(text)}
+	if args.Cards {
+		for _, quote := range result.Quotes {
+			content = append(content, ImageContent(quote.Card(), quotify.CardMIMEType))
+		}
+	}
+	return &mcpv2.CallToolResponse{
+		Content:           content,
+		StructuredContent: structured,
+	}, nil
+}
+
+// toolError reports a tool failure to the client. Invalid arguments are
+// InvalidArgument errors instead.
+func toolError(err error) *mcpv2.CallToolResponse {
+	return &mcpv2.CallToolResponse{
+		Content: []*mcpv2.ToolResult{TextContent("Error: " + err.Error())},
+		IsError: true,
+	}
+}
+
+func (s *QuotifyServer) ListPrompts(ctx context.Context, req *mcpv2.ListPromptsRequest) (*mcpv2.ListPromptsResponse, error) {
+	log.Printf("ListPrompts called")
+
+	var prompts []*mcpv2.Prompt
+	for _, p := range quotify.Prompts {
+		prompt := &mcpv2.Prompt{Name: p.Name, Description: p.Description}
+		for _, a := range p.Args {
+			prompt.Arguments = append(prompt.Arguments, &mcpv2.PromptArgument{
+				Name:        a.Name,
+				Description: a.Description,
+				Required:    a.Required,
+			})
+		}
+		prompts = append(prompts, prompt)
+	}
+	page, next, err := paginate(prompts, (*mcpv2.Prompt).GetName, req.Cursor, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &mcpv2.ListPromptsResponse{
+		Prompts:    page,
+		NextCursor: next,
+	}, nil
+}
+
+func (s *QuotifyServer) GetPrompt(ctx context.Context, req *mcpv2.GetPromptRequest) (*mcpv2.GetPromptResponse, error) {
+	log.Printf("GetPrompt called with name: %s", req.Name)
+
+	p, ok := quotify.LookupPrompt(req.Name)
+	if !ok {
+		return nil, promptNotFound(req.Name)
+	}
+	description, text, err := p.Render(s.quotifier.Load(), req.Arguments)
+	var missing *quotify.MissingArgumentError
+	if errors.As(err, &missing) {
+		return nil, invalidArgument(err.Error(), "arguments."+missing.Arg.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &mcpv2.GetPromptResponse{
+		Description: description,
+		Messages: []*mcpv2.PromptMessage{
+			{
+				Role:    "user",
+				Content: text,
+			},
+		},
+	}, nil
+}
+
+// ListResources lists the author and quote lists and every single quote and
+// author, since the MCPService has no resource templates.
+func (s *QuotifyServer) ListResources(ctx context.Context, req *mcpv2.ListResourcesRequest) (*mcpv2.ListResourcesResponse, error) {
+	log.Printf("ListResources called")
+
+	q := s.quotifier.Load()
+	var resources []*mcpv2.Resource
+	for _, r := range quotify.Resources {
+		resources = append(resources, &mcpv2.Resource{
+			Uri:         r.URI,
+			Name:        r.Name,
+			Description: r.Description,
+			MimeType:    r.MIMEType,
+		})
+	}
+	for _, e := range q.Quotes {
+		resources = append(resources, &mcpv2.Resource{
+			Uri:         quotify.QuoteURI(e.EffectiveID()),
+			Name:        e.Text,
+			Description: "A single quote",
+			MimeType:    quotify.ResourceMIMEType,
+		})
+	}
+	for _, name := range q.AuthorNames() {
+		resources = append(resources, &mcpv2.Resource{
+			Uri:         quotify.AuthorURI(name),
+			Name:        name,
+			Description: "A single author, with the quotes they really said",
+			MimeType:    quotify.ResourceMIMEType,
+		})
+	}
+	page, next, err := paginate(resources, (*mcpv2.Resource).GetUri, req.Cursor, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &mcpv2.ListResourcesResponse{
+		Resources:  page,
+		NextCursor: next,
+	}, nil
+}
+
+func (s *QuotifyServer) ReadResource(ctx context.Context, req *mcpv2.ReadResourceRequest) (*mcpv2.ReadResourceResponse, error) {
+	log.Printf("ReadResource called with URI: %s", req.Uri)
+
+	data, mimeType, err := s.quotifier.Load().ReadResource(req.Uri)
+	if errors.Is(err, quotify.ErrResourceNotFound) {
+		return nil, resourceNotFound(req.Uri)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &mcpv2.ReadResourceResponse{
+		Contents: []*mcpv2.ResourceContent{resourceContent(req.Uri, mimeType, data)},
+	}, nil
+}
+
+// Quote feed intervals.
+const (
+	defaultStreamInterval = time.Second
+	minStreamInterval     = 100 * time.Millisecond
+)
+
+// StreamQuotes sends quotes from the corpus as it was when the stream
+// started, until count quotes have been sent or the stream's context is
+// done, because the client went away or, with StopStreams, the server is
+// shutting down.
+func (s *QuotifyServer) StreamQuotes(req *mcpv2.StreamQuotesRequest, stream mcpv2.MCPService_StreamQuotesServer) error {
+	log.Printf("StreamQuotes called with interval %dms and count %d", req.IntervalMs, req.Count)
+
+	interval := defaultStreamInterval
+	if req.IntervalMs != 0 {
+		interval = time.Duration(req.IntervalMs) * time.Millisecond
+	}
+	if interval < minStreamInterval {
+		return invalidArgument(fmt.Sprintf("interval_ms must be at least %d", minStreamInterval.Milliseconds()), "interval_ms")
+	}
+	next, err := s.quotifier.Load().Feed(quotify.ToolArgs{
+		Mode:       req.Mode,
+		Category:   req.Category,
+		Tags:       req.Tags,
+		AuthorTags: req.AuthorTags,
+		Seed:       req.Seed,
+	})
+	var argErr *quotify.ArgumentError
+	if errors.As(err, &argErr) {
+		return invalidArgument(err.Error(), argErr.Arg)
+	}
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	ctx := stream.Context()
+	for seq := uint32(1); req.Count == 0 || seq <= req.Count; seq++ {
+		if seq > 1 {
+			select {
+			case <-ctx.Done():
+				return contextError(ctx)
+			case <-ticker.C:
+			}
+		}
+		quote, err := next()
+		if errors.Is(err, quotify.ErrNoMatch) {
+			return invalidArgument(err.Error(), filterFields(req)...)
+		}
+		if err != nil {
+			return err
+		}
+		err = stream.Send(&mcpv2.StreamedQuote{
+			Sequence:   seq,
+			Id:         quote.ID,
+			Text:       quote.Text,
+			Author:     quote.Author,
+			Tags:       quote.Tags,
+			AuthorTags: quote.AuthorTags,
+			Source:     quote.Source,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterFields returns the names of the fields narrowing down the quotes
+// req streams, one or more of which matched nothing.
+func filterFields(req *mcpv2.StreamQuotesRequest) []string {
+	var fields []string
+	if req.Category != "" {
+		fields = append(fields, "category")
+	}
+	if len(req.Tags) > 0 {
+		fields = append(fields, "tags")
+	}
+	if len(req.AuthorTags) > 0 {
+		fields = append(fields, "author_tags")
+	}
+	if req.Mode == "authentic" || req.Mode == "quiz" {
+		fields = append(fields, "mode")
+	}
+	return fields
+}
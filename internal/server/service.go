@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
+	"github.com/example/mcp-testing/pkg/quotify"
+)
+
+// NewService returns the named v2 MCPService implementation. The quotify
+// service reads its corpus from corpusPath, or uses the built-in corpus if
+// it is empty, and polls it for changes every reloadInterval until ctx is
+// done; a zero interval disables reloading.
+func NewService(ctx context.Context, name, corpusPath string, reloadInterval time.Duration) (mcpv2.MCPServiceServer, error) {
+	switch name {
+	case "reference":
+		return NewMCPServer(), nil
+	case "quotify":
+		if corpusPath == "" {
+			return NewQuotifyServer(quotify.New()), nil
+		}
+		s := &QuotifyServer{}
+		if err := quotify.LoadAndWatch(ctx, corpusPath, reloadInterval, s.SetQuotify); err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unknown service '%s' (want reference or quotify)", name)
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errShuttingDown is the cause streaming calls are cancelled with when the
+// server shuts down; clients may retry elsewhere.
+var errShuttingDown = status.Error(codes.Unavailable, "server is shutting down")
+
+// StopStreams returns a stream interceptor that cancels streaming calls once
+// ctx is done, so that long-lived streams such as endless quote feeds end
+// rather than hold up a graceful stop. Cancel ctx before GracefulStop.
+func StopStreams(ctx context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		streamCtx, cancel := context.WithCancelCause(ss.Context())
+		defer cancel(nil)
+		stop := context.AfterFunc(ctx, func() { cancel(errShuttingDown) })
+		defer stop()
+		return handler(srv, stoppableStream{ss, streamCtx})
+	}
+}
+
+type stoppableStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s stoppableStream) Context() context.Context {
+	return s.ctx
+}
+
+// contextError returns the error for a call whose context is done: the
+// status it was cancelled with, as on shutdown, or else the status for
+// ctx.Err().
+func contextError(ctx context.Context) error {
+	if cause := context.Cause(ctx); cause != ctx.Err() {
+		if st, ok := status.FromError(cause); ok {
+			return st.Err()
+		}
+	}
+	return status.FromContextError(ctx.Err()).Err()
+}
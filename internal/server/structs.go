@@ -0,0 +1,43 @@
+package server
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// mustStruct returns m as a Struct. It panics if m holds values that have
+// no JSON equivalent, so use it for literals only.
+func mustStruct(m map[string]any) *structpb.Struct {
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// toStruct returns the JSON encoding of v, which must encode as an object,
+// as a Struct.
+func toStruct(v any) (*structpb.Struct, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	s := &structpb.Struct{}
+	if err := s.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// fromStruct decodes s into v as if it were JSON. A nil s leaves v as it is.
+func fromStruct(s *structpb.Struct, v any) error {
+	if s == nil {
+		return nil
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
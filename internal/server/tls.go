@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/example/mcp-testing/pkg/quotify"
+)
+
+// TLSConfig returns a server TLS configuration serving the certificate and
+// key in certFile and keyFile. If clientCAFile is not empty, clients must
+// present a certificate signed by one of the CAs in it (mutual TLS).
+//
+// The files are polled every reloadInterval until ctx is done, and picked
+// up by new connections when they change, so certificates can be renewed
+// without a restart. If the new files do not load, for instance because
+// only the certificate has been replaced so far, the previous ones are kept
+// until the next change. A zero interval disables reloading.
+func TLSConfig(ctx context.Context, certFile, keyFile, clientCAFile string, reloadInterval time.Duration) (*tls.Config, error) {
+	files := []string{certFile, keyFile}
+	if clientCAFile != "" {
+		files = append(files, clientCAFile)
+	}
+	stamp := quotify.FileStamp(files...)
+	config, err := loadTLSConfig(certFile, keyFile, clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	var current atomic.Pointer[tls.Config]
+	current.Store(config)
+
+	if reloadInterval > 0 {
+		go func() {
+			ticker := time.NewTicker(reloadInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+
+				s := quotify.FileStamp(files...)
+				if s == stamp {
+					continue
+				}
+				stamp = s
+				config, err := loadTLSConfig(certFile, keyFile, clientCAFile)
+				if err != nil {
+					log.Printf("TLS reload failed, keeping previous certificates: %v", err)
+					continue
+				}
+				current.Store(config)
+				log.Printf("Reloaded TLS certificates from %s", strings.Join(files, ", "))
+			}
+		}()
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return current.Load(), nil
+		},
+	}, nil
+}
+
+// loadTLSConfig loads a complete server configuration from the files.
+func loadTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading certificate: %w", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		// gRPC requires HTTP/2 to be negotiated, and the configuration
+		// returned for a connection replaces the one gRPC set it up on.
+		NextProtos: []string{"h2"},
+	}
+	if clientCAFile != "" {
+		pool, err := LoadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// LoadCertPool returns a pool of the PEM certificates in file.
+func LoadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("loading CA bundle: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("loading CA bundle: no certificates in %s", file)
+	}
+	return pool, nil
+}
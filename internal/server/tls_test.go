@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA is a throwaway certificate authority.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key := newKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key for a leaf with the given serial
+// number and extended key usage.
+func (ca *testCA) issue(t *testing.T, serial int64, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key := newKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// tlsFiles holds the paths of a server's TLS files.
+type tlsFiles struct {
+	cert, key, clientCA string
+}
+
+func writeServerFiles(t *testing.T, dir string, ca *testCA, serial int64) tlsFiles {
+	t.Helper()
+	files := tlsFiles{
+		cert:     filepath.Join(dir, "server.pem"),
+		key:      filepath.Join(dir, "server.key"),
+		clientCA: filepath.Join(dir, "ca.pem"),
+	}
+	certPEM, keyPEM := ca.issue(t, serial, x509.ExtKeyUsageServerAuth)
+	writeFile(t, files.cert, certPEM)
+	writeFile(t, files.key, keyPEM)
+	writeFile(t, files.clientCA, ca.pem)
+	return files
+}
+
+// handshake connects to a listener serving config, returning the client's
+// view of the connection and the server's handshake error.
+func handshake(t *testing.T, config *tls.Config, client *tls.Config) (tls.ConnectionState, error, error) {
+	t.Helper()
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, clientErr := tls.Dial("tcp", lis.Addr().String(), client)
+	var state tls.ConnectionState
+	if clientErr == nil {
+		state = conn.ConnectionState()
+		conn.Close()
+	}
+	return state, clientErr, <-serverErr
+}
+
+func clientConfig(t *testing.T, ca *testCA, certPEM, keyPEM []byte) *tls.Config {
+	t.Helper()
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(ca.pem)
+	config := &tls.Config{RootCAs: pool, ServerName: "localhost"}
+	if certPEM != nil {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config
+}
+
+func TestTLSConfig(t *testing.T) {
+	ca := newTestCA(t)
+	files := writeServerFiles(t, t.TempDir(), ca, 2)
+	config, err := TLSConfig(t.Context(), files.cert, files.key, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, clientErr, serverErr := handshake(t, config, clientConfig(t, ca, nil, nil))
+	if clientErr != nil || serverErr != nil {
+		t.Fatalf("handshake failed: client %v, server %v", clientErr, serverErr)
+	}
+	if got := state.PeerCertificates[0].SerialNumber.Int64(); got != 2 {
+		t.Errorf("server presented certificate %d, want 2", got)
+	}
+}
+
+func TestTLSConfigMutual(t *testing.T) {
+	ca := newTestCA(t)
+	files := writeServerFiles(t, t.TempDir(), ca, 2)
+	config, err := TLSConfig(t.Context(), files.cert, files.key, files.clientCA, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientCert, clientKey := ca.issue(t, 3, x509.ExtKeyUsageClientAuth)
+	if _, clientErr, serverErr := handshake(t, config, clientConfig(t, ca, clientCert, clientKey)); clientErr != nil || serverErr != nil {
+		t.Errorf("handshake with client certificate failed: client %v, server %v", clientErr, serverErr)
+	}
+
+	if _, _, serverErr := handshake(t, config, clientConfig(t, ca, nil, nil)); serverErr == nil {
+		t.Error("handshake without client certificate succeeded")
+	}
+
+	other := newTestCA(t)
+	otherCert, otherKey := other.issue(t, 3, x509.ExtKeyUsageClientAuth)
+	if _, _, serverErr := handshake(t, config, clientConfig(t, ca, otherCert, otherKey)); serverErr == nil {
+		t.Error("handshake with client certificate from another CA succeeded")
+	}
+}
+
+func TestTLSConfigReload(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	files := writeServerFiles(t, dir, ca, 2)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	const interval = 20 * time.Millisecond
+	config, err := TLSConfig(ctx, files.cert, files.key, "", interval)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := clientConfig(t, ca, nil, nil)
+
+	serial := func() int64 {
+		t.Helper()
+		state, clientErr, serverErr := handshake(t, config, client)
+		if clientErr != nil || serverErr != nil {
+			t.Fatalf("handshake failed: client %v, server %v", clientErr, serverErr)
+		}
+		return state.PeerCertificates[0].SerialNumber.Int64()
+	}
+	waitForSerial := func(want int64) {
+		t.Helper()
+		deadline := time.Now().Add(50 * interval)
+		for serial() != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("server still presents the old certificate, want %d", want)
+			}
+			time.Sleep(interval)
+		}
+	}
+
+	// A renewed certificate is picked up.
+	writeServerFiles(t, dir, ca, 4)
+	waitForSerial(4)
+
+	// A broken key is not, and the previous certificate stays in service.
+	writeFile(t, files.key, []byte("not a key"))
+	time.Sleep(5 * interval)
+	if got := serial(); got != 4 {
+		t.Errorf("server presented certificate %d after a bad reload, want 4", got)
+	}
+
+	// Fixing the files is picked up again.
+	writeServerFiles(t, dir, ca, 5)
+	waitForSerial(5)
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp"
+	mcpv2 "github.com/example/mcp-testing/pkg/github.com/example/mcp-testing/pkg/mcp/v2"
+)
+
+// V1 serves s to clients of the v1 MCPService, whose tool arguments and
+// input schemas are maps of strings.
+//
+// Schemas are sent the v1 way: string values as they are and anything else
+// as JSON. Tool arguments are converted back using the tool's input schema:
+// arguments of type string are taken literally, and the others are parsed
+// as JSON, falling back to the plain string if they do not parse.
+func V1(s mcpv2.MCPServiceServer) mcp.MCPServiceServer {
+	return &v1Server{s: s}
+}
+
+type v1Server struct {
+	mcp.UnimplementedMCPServiceServer
+
+	s mcpv2.MCPServiceServer
+}
+
+// convert copies from into to, which must be wire-compatible messages of the
+// two versions. Fields only known to from are dropped.
+func convert(from, to proto.Message) error {
+	data, err := proto.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, to)
+}
+
+// call converts req, passes it to f and converts the response back.
+func call[Req1, Resp1, Req2, Resp2 proto.Message](ctx context.Context, req Req1, req2 Req2, resp Resp1, f func(context.Context, Req2) (Resp2, error)) (Resp1, error) {
+	var zero Resp1
+	if err := convert(req, req2); err != nil {
+		return zero, err
+	}
+	resp2, err := f(ctx, req2)
+	if err != nil {
+		return zero, err
+	}
+	if err := convert(resp2, resp); err != nil {
+		return zero, err
+	}
+	return resp, nil
+}
+
+func (v *v1Server) Initialize(ctx context.Context, req *mcp.InitializeRequest) (*mcp.InitializeResponse, error) {
+	return call(ctx, req, &mcpv2.InitializeRequest{}, &mcp.InitializeResponse{}, v.s.Initialize)
+}
+
+func (v *v1Server) ListTools(ctx context.Context, req *mcp.ListToolsRequest) (*mcp.ListToolsResponse, error) {
+	resp, err := v.s.ListTools(ctx, &mcpv2.ListToolsRequest{Cursor: req.Cursor, PageSize: req.PageSize})
+	if err != nil {
+		return nil, err
+	}
+	tools := make([]*mcp.Tool, len(resp.Tools))
+	for i, t := range resp.Tools {
+		schema, err := v1Schema(t.InputSchema)
+		if err != nil {
+			return nil, err
+		}
+		tools[i] = &mcp.Tool{
+			Name:        t.Name,
+			Description: t.Description,
+			InputSchema: schema,
+		}
+	}
+	return &mcp.ListToolsResponse{
+		Tools:      tools,
+		NextCursor: resp.NextCursor,
+	}, nil
+}
+
+// v1Schema flattens schema into a map of strings.
+func v1Schema(schema *structpb.Struct) (map[string]string, error) {
+	m := make(map[string]string, len(schema.GetFields()))
+	for name, value := range schema.GetFields() {
+		if s, ok := value.GetKind().(*structpb.Value_StringValue); ok {
+			m[name] = s.StringValue
+			continue
+		}
+		data, err := value.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		m[name] = string(data)
+	}
+	return m, nil
+}
+
+func (v *v1Server) CallTool(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResponse, error) {
+	schema, err := v.inputSchema(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	args := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(req.Arguments))}
+	for name, value := range req.Arguments {
+		args.Fields[name] = v2Argument(value, propertyType(schema, name))
+	}
+	resp, err := v.s.CallTool(ctx, &mcpv2.CallToolRequest{Name: req.Name, Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	resp1 := &mcp.CallToolResponse{}
+	if err := convert(resp, resp1); err != nil {
+		return nil, err
+	}
+	return resp1, nil
+}
+
+// inputSchema returns the input schema of the named tool, or nil if there
+// is no such tool.
+func (v *v1Server) inputSchema(ctx context.Context, name string) (*structpb.Struct, error) {
+	req := &mcpv2.ListToolsRequest{}
+	for {
+		resp, err := v.s.ListTools(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range resp.Tools {
+			if t.Name == name {
+				return t.InputSchema, nil
+			}
+		}
+		if resp.NextCursor == "" {
+			return nil, nil
+		}
+		req.Cursor = resp.NextCursor
+	}
+}
+
+// propertyType returns the declared JSON type of the named property, or ""
+// if it has none.
+func propertyType(schema *structpb.Struct, name string) string {
+	property := schema.GetFields()["properties"].GetStructValue().GetFields()[name]
+	return property.GetStructValue().GetFields()["type"].GetStringValue()
+}
+
+// v2Argument converts a v1 argument with the given declared type.
+func v2Argument(value, typ string) *structpb.Value {
+	if typ != "string" {
+		v := &structpb.Value{}
+		if v.UnmarshalJSON([]byte(value)) == nil {
+			return v
+		}
+	}
+	return structpb.NewStringValue(value)
+}
+
+func (v *v1Server) ListPrompts(ctx context.Context, req *mcp.ListPromptsRequest) (*mcp.ListPromptsResponse, error) {
+	return call(ctx, req, &mcpv2.ListPromptsRequest{}, &mcp.ListPromptsResponse{}, v.s.ListPrompts)
+}
+
+func (v *v1Server) GetPrompt(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResponse, error) {
+	return call(ctx, req, &mcpv2.GetPromptRequest{}, &mcp.GetPromptResponse{}, v.s.GetPrompt)
+}
+
+func (v *v1Server) ListResources(ctx context.Context, req *mcp.ListResourcesRequest) (*mcp.ListResourcesResponse, error) {
+	return call(ctx, req, &mcpv2.ListResourcesRequest{}, &mcp.ListResourcesResponse{}, v.s.ListResources)
+}
+
+func (v *v1Server) ReadResource(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResponse, error) {
+	return call(ctx, req, &mcpv2.ReadResourceRequest{}, &mcp.ReadResourceResponse{}, v.s.ReadResource)
+}
+
+func (v *v1Server) StreamQuotes(req *mcp.StreamQuotesRequest, stream mcp.MCPService_StreamQuotesServer) error {
+	req2 := &mcpv2.StreamQuotesRequest{}
+	if err := convert(req, req2); err != nil {
+		return err
+	}
+	return v.s.StreamQuotes(req2, v1QuoteStream{stream})
+}
+
+// v1QuoteStream sends v2 quotes down a v1 stream.
+type v1QuoteStream struct {
+	mcp.MCPService_StreamQuotesServer
+}
+
+func (s v1QuoteStream) Send(quote *mcpv2.StreamedQuote) error {
+	quote1 := &mcp.StreamedQuote{}
+	if err := convert(quote, quote1); err != nil {
+		return err
+	}
+	return s.MCPService_StreamQuotesServer.Send(quote1)
+}
@@ -368,8 +368,12 @@ func (x *ServerInfo) GetVersion() string {
 
 // Tool messages
 type ListToolsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// next_cursor of the previous page, or empty for the first page
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Maximum number of items to return; 0 uses the server default, and
+	// servers may return fewer
+	PageSize      uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -411,6 +415,13 @@ func (x *ListToolsRequest) GetCursor() string {
 	return ""
 }
 
+func (x *ListToolsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type ListToolsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tools         []*Tool                `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
@@ -628,9 +639,17 @@ func (x *CallToolResponse) GetIsError() bool {
 }
 
 type ToolResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "text", "image", "audio", "resource" (embedded) or "resource_link"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Raw data and MIME type of image and audio content
+	Data     []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	MimeType string `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// Contents of an embedded resource
+	Resource *ResourceContent `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Target of a resource link
+	ResourceLink  *Resource `protobuf:"bytes,6,opt,name=resource_link,json=resourceLink,proto3" json:"resource_link,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -679,10 +698,42 @@ func (x *ToolResult) GetText() string {
 	return ""
 }
 
+func (x *ToolResult) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ToolResult) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ToolResult) GetResource() *ResourceContent {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *ToolResult) GetResourceLink() *Resource {
+	if x != nil {
+		return x.ResourceLink
+	}
+	return nil
+}
+
 // Prompt messages
 type ListPromptsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// next_cursor of the previous page, or empty for the first page
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Maximum number of items to return; 0 uses the server default, and
+	// servers may return fewer
+	PageSize      uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -724,6 +775,13 @@ func (x *ListPromptsRequest) GetCursor() string {
 	return ""
 }
 
+func (x *ListPromptsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type ListPromptsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Prompts       []*Prompt              `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
@@ -1054,8 +1112,12 @@ func (x *PromptMessage) GetContent() string {
 
 // Resource messages
 type ListResourcesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// next_cursor of the previous page, or empty for the first page
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Maximum number of items to return; 0 uses the server default, and
+	// servers may return fewer
+	PageSize      uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1097,6 +1159,13 @@ func (x *ListResourcesRequest) GetCursor() string {
 	return ""
 }
 
+func (x *ListResourcesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type ListResourcesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Resources     []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
@@ -1306,10 +1375,12 @@ func (x *ReadResourceResponse) GetContents() []*ResourceContent {
 }
 
 type ResourceContent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Uri      string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	MimeType string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// Either text or, for binary contents, blob is set
+	Text          string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Blob          []byte `protobuf:"bytes,4,opt,name=blob,proto3" json:"blob,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1365,6 +1436,206 @@ func (x *ResourceContent) GetText() string {
 	return ""
 }
 
+func (x *ResourceContent) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// Quote feed messages
+type StreamQuotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time between quotes in milliseconds (default 1000, at least 100); the
+	// first quote is sent immediately
+	IntervalMs uint32 `protobuf:"varint,1,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	// Number of quotes to send; 0 streams until the client cancels
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// "chaos" (default), "authentic" or "quiz", as for the quotify tool
+	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Only send quotes in this category and carrying all of tags, attributed
+	// to authors carrying all of author_tags
+	Category   string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tags       []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	AuthorTags []string `protobuf:"bytes,6,rep,name=author_tags,json=authorTags,proto3" json:"author_tags,omitempty"`
+	// Seed for a reproducible feed
+	Seed          *int64 `protobuf:"varint,7,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamQuotesRequest) Reset() {
+	*x = StreamQuotesRequest{}
+	mi := &file_mcp_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQuotesRequest) ProtoMessage() {}
+
+func (x *StreamQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQuotesRequest.ProtoReflect.Descriptor instead.
+func (*StreamQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_mcp_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StreamQuotesRequest) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *StreamQuotesRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StreamQuotesRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *StreamQuotesRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *StreamQuotesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *StreamQuotesRequest) GetAuthorTags() []string {
+	if x != nil {
+		return x.AuthorTags
+	}
+	return nil
+}
+
+func (x *StreamQuotesRequest) GetSeed() int64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+type StreamedQuote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position in the stream, starting at 1
+	Sequence      uint32   `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Id            string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string   `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Author        string   `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	AuthorTags    []string `protobuf:"bytes,6,rep,name=author_tags,json=authorTags,proto3" json:"author_tags,omitempty"`
+	Source        string   `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamedQuote) Reset() {
+	*x = StreamedQuote{}
+	mi := &file_mcp_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamedQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamedQuote) ProtoMessage() {}
+
+func (x *StreamedQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_mcp_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamedQuote.ProtoReflect.Descriptor instead.
+func (*StreamedQuote) Descriptor() ([]byte, []int) {
+	return file_mcp_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StreamedQuote) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *StreamedQuote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamedQuote) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *StreamedQuote) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *StreamedQuote) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *StreamedQuote) GetAuthorTags() []string {
+	if x != nil {
+		return x.AuthorTags
+	}
+	return nil
+}
+
+func (x *StreamedQuote) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
 var File_mcp_proto protoreflect.FileDescriptor
 
 const file_mcp_proto_rawDesc = "" +
@@ -1395,9 +1666,10 @@ const file_mcp_proto_rawDesc = "" +
 	"\n" +
 	"ServerInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\"*\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"G\n" +
 	"\x10ListToolsRequest\x12\x16\n" +
-	"\x06cursor\x18\x01 \x01(\tR\x06cursor\"U\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"U\n" +
 	"\x11ListToolsResponse\x12\x1f\n" +
 	"\x05tools\x18\x01 \x03(\v2\t.mcp.ToolR\x05tools\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
@@ -1417,13 +1689,18 @@ const file_mcp_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
 	"\x10CallToolResponse\x12)\n" +
 	"\acontent\x18\x01 \x03(\v2\x0f.mcp.ToolResultR\acontent\x12\x19\n" +
-	"\bis_error\x18\x02 \x01(\bR\aisError\"4\n" +
+	"\bis_error\x18\x02 \x01(\bR\aisError\"\xcb\x01\n" +
 	"\n" +
 	"ToolResult\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\",\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x1b\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x120\n" +
+	"\bresource\x18\x05 \x01(\v2\x14.mcp.ResourceContentR\bresource\x122\n" +
+	"\rresource_link\x18\x06 \x01(\v2\r.mcp.ResourceR\fresourceLink\"I\n" +
 	"\x12ListPromptsRequest\x12\x16\n" +
-	"\x06cursor\x18\x01 \x01(\tR\x06cursor\"]\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"]\n" +
 	"\x13ListPromptsResponse\x12%\n" +
 	"\aprompts\x18\x01 \x03(\v2\v.mcp.PromptR\aprompts\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
@@ -1447,9 +1724,10 @@ const file_mcp_proto_rawDesc = "" +
 	"\bmessages\x18\x02 \x03(\v2\x12.mcp.PromptMessageR\bmessages\"=\n" +
 	"\rPromptMessage\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\".\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"K\n" +
 	"\x14ListResourcesRequest\x12\x16\n" +
-	"\x06cursor\x18\x01 \x01(\tR\x06cursor\"e\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"e\n" +
 	"\x15ListResourcesResponse\x12+\n" +
 	"\tresources\x18\x01 \x03(\v2\r.mcp.ResourceR\tresources\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
@@ -1462,11 +1740,32 @@ const file_mcp_proto_rawDesc = "" +
 	"\x13ReadResourceRequest\x12\x10\n" +
 	"\x03uri\x18\x01 \x01(\tR\x03uri\"H\n" +
 	"\x14ReadResourceResponse\x120\n" +
-	"\bcontents\x18\x01 \x03(\v2\x14.mcp.ResourceContentR\bcontents\"T\n" +
+	"\bcontents\x18\x01 \x03(\v2\x14.mcp.ResourceContentR\bcontents\"h\n" +
 	"\x0fResourceContent\x12\x10\n" +
 	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x1b\n" +
 	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x12\n" +
-	"\x04text\x18\x03 \x01(\tR\x04text2\xcb\x03\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x12\n" +
+	"\x04blob\x18\x04 \x01(\fR\x04blob\"\xd3\x01\n" +
+	"\x13StreamQuotesRequest\x12\x1f\n" +
+	"\vinterval_ms\x18\x01 \x01(\rR\n" +
+	"intervalMs\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1f\n" +
+	"\vauthor_tags\x18\x06 \x03(\tR\n" +
+	"authorTags\x12\x17\n" +
+	"\x04seed\x18\a \x01(\x03H\x00R\x04seed\x88\x01\x01B\a\n" +
+	"\x05_seed\"\xb4\x01\n" +
+	"\rStreamedQuote\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\rR\bsequence\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1f\n" +
+	"\vauthor_tags\x18\x06 \x03(\tR\n" +
+	"authorTags\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source2\x8b\x04\n" +
 	"\n" +
 	"MCPService\x12=\n" +
 	"\n" +
@@ -1476,7 +1775,8 @@ const file_mcp_proto_rawDesc = "" +
 	"\vListPrompts\x12\x17.mcp.ListPromptsRequest\x1a\x18.mcp.ListPromptsResponse\x12:\n" +
 	"\tGetPrompt\x12\x15.mcp.GetPromptRequest\x1a\x16.mcp.GetPromptResponse\x12F\n" +
 	"\rListResources\x12\x19.mcp.ListResourcesRequest\x1a\x1a.mcp.ListResourcesResponse\x12C\n" +
-	"\fReadResource\x12\x18.mcp.ReadResourceRequest\x1a\x19.mcp.ReadResourceResponseB(Z&github.com/example/mcp-testing/pkg/mcpb\x06proto3"
+	"\fReadResource\x12\x18.mcp.ReadResourceRequest\x1a\x19.mcp.ReadResourceResponse\x12>\n" +
+	"\fStreamQuotes\x12\x18.mcp.StreamQuotesRequest\x1a\x12.mcp.StreamedQuote0\x01B(Z&github.com/example/mcp-testing/pkg/mcpb\x06proto3"
 
 var (
 	file_mcp_proto_rawDescOnce sync.Once
@@ -1490,7 +1790,7 @@ func file_mcp_proto_rawDescGZIP() []byte {
 	return file_mcp_proto_rawDescData
 }
 
-var file_mcp_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_mcp_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_mcp_proto_goTypes = []any{
 	(*InitializeRequest)(nil),     // 0: mcp.InitializeRequest
 	(*InitializeResponse)(nil),    // 1: mcp.InitializeResponse
@@ -1517,9 +1817,11 @@ var file_mcp_proto_goTypes = []any{
 	(*ReadResourceRequest)(nil),   // 22: mcp.ReadResourceRequest
 	(*ReadResourceResponse)(nil),  // 23: mcp.ReadResourceResponse
 	(*ResourceContent)(nil),       // 24: mcp.ResourceContent
-	nil,                           // 25: mcp.Tool.InputSchemaEntry
-	nil,                           // 26: mcp.CallToolRequest.ArgumentsEntry
-	nil,                           // 27: mcp.GetPromptRequest.ArgumentsEntry
+	(*StreamQuotesRequest)(nil),   // 25: mcp.StreamQuotesRequest
+	(*StreamedQuote)(nil),         // 26: mcp.StreamedQuote
+	nil,                           // 27: mcp.Tool.InputSchemaEntry
+	nil,                           // 28: mcp.CallToolRequest.ArgumentsEntry
+	nil,                           // 29: mcp.GetPromptRequest.ArgumentsEntry
 }
 var file_mcp_proto_depIdxs = []int32{
 	2,  // 0: mcp.InitializeRequest.capabilities:type_name -> mcp.ClientCapabilities
@@ -1527,34 +1829,38 @@ var file_mcp_proto_depIdxs = []int32{
 	3,  // 2: mcp.InitializeResponse.capabilities:type_name -> mcp.ServerCapabilities
 	5,  // 3: mcp.InitializeResponse.server_info:type_name -> mcp.ServerInfo
 	8,  // 4: mcp.ListToolsResponse.tools:type_name -> mcp.Tool
-	25, // 5: mcp.Tool.input_schema:type_name -> mcp.Tool.InputSchemaEntry
-	26, // 6: mcp.CallToolRequest.arguments:type_name -> mcp.CallToolRequest.ArgumentsEntry
+	27, // 5: mcp.Tool.input_schema:type_name -> mcp.Tool.InputSchemaEntry
+	28, // 6: mcp.CallToolRequest.arguments:type_name -> mcp.CallToolRequest.ArgumentsEntry
 	11, // 7: mcp.CallToolResponse.content:type_name -> mcp.ToolResult
-	14, // 8: mcp.ListPromptsResponse.prompts:type_name -> mcp.Prompt
-	15, // 9: mcp.Prompt.arguments:type_name -> mcp.PromptArgument
-	27, // 10: mcp.GetPromptRequest.arguments:type_name -> mcp.GetPromptRequest.ArgumentsEntry
-	18, // 11: mcp.GetPromptResponse.messages:type_name -> mcp.PromptMessage
-	21, // 12: mcp.ListResourcesResponse.resources:type_name -> mcp.Resource
-	24, // 13: mcp.ReadResourceResponse.contents:type_name -> mcp.ResourceContent
-	0,  // 14: mcp.MCPService.Initialize:input_type -> mcp.InitializeRequest
-	6,  // 15: mcp.MCPService.ListTools:input_type -> mcp.ListToolsRequest
-	9,  // 16: mcp.MCPService.CallTool:input_type -> mcp.CallToolRequest
-	12, // 17: mcp.MCPService.ListPrompts:input_type -> mcp.ListPromptsRequest
-	16, // 18: mcp.MCPService.GetPrompt:input_type -> mcp.GetPromptRequest
-	19, // 19: mcp.MCPService.ListResources:input_type -> mcp.ListResourcesRequest
-	22, // 20: mcp.MCPService.ReadResource:input_type -> mcp.ReadResourceRequest
-	1,  // 21: mcp.MCPService.Initialize:output_type -> mcp.InitializeResponse
-	7,  // 22: mcp.MCPService.ListTools:output_type -> mcp.ListToolsResponse
-	10, // 23: mcp.MCPService.CallTool:output_type -> mcp.CallToolResponse
-	13, // 24: mcp.MCPService.ListPrompts:output_type -> mcp.ListPromptsResponse
-	17, // 25: mcp.MCPService.GetPrompt:output_type -> mcp.GetPromptResponse
-	20, // 26: mcp.MCPService.ListResources:output_type -> mcp.ListResourcesResponse
-	23, // 27: mcp.MCPService.ReadResource:output_type -> mcp.ReadResourceResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	24, // 8: mcp.ToolResult.resource:type_name -> mcp.ResourceContent
+	21, // 9: mcp.ToolResult.resource_link:type_name -> mcp.Resource
+	14, // 10: mcp.ListPromptsResponse.prompts:type_name -> mcp.Prompt
+	15, // 11: mcp.Prompt.arguments:type_name -> mcp.PromptArgument
+	29, // 12: mcp.GetPromptRequest.arguments:type_name -> mcp.GetPromptRequest.ArgumentsEntry
+	18, // 13: mcp.GetPromptResponse.messages:type_name -> mcp.PromptMessage
+	21, // 14: mcp.ListResourcesResponse.resources:type_name -> mcp.Resource
+	24, // 15: mcp.ReadResourceResponse.contents:type_name -> mcp.ResourceContent
+	0,  // 16: mcp.MCPService.Initialize:input_type -> mcp.InitializeRequest
+	6,  // 17: mcp.MCPService.ListTools:input_type -> mcp.ListToolsRequest
+	9,  // 18: mcp.MCPService.CallTool:input_type -> mcp.CallToolRequest
+	12, // 19: mcp.MCPService.ListPrompts:input_type -> mcp.ListPromptsRequest
+	16, // 20: mcp.MCPService.GetPrompt:input_type -> mcp.GetPromptRequest
+	19, // 21: mcp.MCPService.ListResources:input_type -> mcp.ListResourcesRequest
+	22, // 22: mcp.MCPService.ReadResource:input_type -> mcp.ReadResourceRequest
+	25, // 23: mcp.MCPService.StreamQuotes:input_type -> mcp.StreamQuotesRequest
+	1,  // 24: mcp.MCPService.Initialize:output_type -> mcp.InitializeResponse
+	7,  // 25: mcp.MCPService.ListTools:output_type -> mcp.ListToolsResponse
+	10, // 26: mcp.MCPService.CallTool:output_type -> mcp.CallToolResponse
+	13, // 27: mcp.MCPService.ListPrompts:output_type -> mcp.ListPromptsResponse
+	17, // 28: mcp.MCPService.GetPrompt:output_type -> mcp.GetPromptResponse
+	20, // 29: mcp.MCPService.ListResources:output_type -> mcp.ListResourcesResponse
+	23, // 30: mcp.MCPService.ReadResource:output_type -> mcp.ReadResourceResponse
+	26, // 31: mcp.MCPService.StreamQuotes:output_type -> mcp.StreamedQuote
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_mcp_proto_init() }
@@ -1562,13 +1868,14 @@ func file_mcp_proto_init() {
 	if File_mcp_proto != nil {
 		return
 	}
+	file_mcp_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mcp_proto_rawDesc), len(file_mcp_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
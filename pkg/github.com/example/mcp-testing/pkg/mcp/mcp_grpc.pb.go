@@ -26,6 +26,7 @@ const (
 	MCPService_GetPrompt_FullMethodName     = "/mcp.MCPService/GetPrompt"
 	MCPService_ListResources_FullMethodName = "/mcp.MCPService/ListResources"
 	MCPService_ReadResource_FullMethodName  = "/mcp.MCPService/ReadResource"
+	MCPService_StreamQuotes_FullMethodName  = "/mcp.MCPService/StreamQuotes"
 )
 
 // MCPServiceClient is the client API for MCPService service.
@@ -48,6 +49,9 @@ type MCPServiceClient interface {
 	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
 	// Read a specific resource
 	ReadResource(ctx context.Context, in *ReadResourceRequest, opts ...grpc.CallOption) (*ReadResourceResponse, error)
+	// Stream quotes at a fixed interval until count is reached or the client
+	// cancels
+	StreamQuotes(ctx context.Context, in *StreamQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamedQuote], error)
 }
 
 type mCPServiceClient struct {
@@ -128,6 +132,25 @@ func (c *mCPServiceClient) ReadResource(ctx context.Context, in *ReadResourceReq
 	return out, nil
 }
 
+func (c *mCPServiceClient) StreamQuotes(ctx context.Context, in *StreamQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamedQuote], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MCPService_ServiceDesc.Streams[0], MCPService_StreamQuotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamQuotesRequest, StreamedQuote]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MCPService_StreamQuotesClient = grpc.ServerStreamingClient[StreamedQuote]
+
 // MCPServiceServer is the server API for MCPService service.
 // All implementations must embed UnimplementedMCPServiceServer
 // for forward compatibility.
@@ -148,6 +171,9 @@ type MCPServiceServer interface {
 	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
 	// Read a specific resource
 	ReadResource(context.Context, *ReadResourceRequest) (*ReadResourceResponse, error)
+	// Stream quotes at a fixed interval until count is reached or the client
+	// cancels
+	StreamQuotes(*StreamQuotesRequest, grpc.ServerStreamingServer[StreamedQuote]) error
 	mustEmbedUnimplementedMCPServiceServer()
 }
 
@@ -179,6 +205,9 @@ func (UnimplementedMCPServiceServer) ListResources(context.Context, *ListResourc
 func (UnimplementedMCPServiceServer) ReadResource(context.Context, *ReadResourceRequest) (*ReadResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadResource not implemented")
 }
+func (UnimplementedMCPServiceServer) StreamQuotes(*StreamQuotesRequest, grpc.ServerStreamingServer[StreamedQuote]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamQuotes not implemented")
+}
 func (UnimplementedMCPServiceServer) mustEmbedUnimplementedMCPServiceServer() {}
 func (UnimplementedMCPServiceServer) testEmbeddedByValue()                    {}
 
@@ -326,6 +355,17 @@ func _MCPService_ReadResource_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MCPService_StreamQuotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamQuotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MCPServiceServer).StreamQuotes(m, &grpc.GenericServerStream[StreamQuotesRequest, StreamedQuote]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MCPService_StreamQuotesServer = grpc.ServerStreamingServer[StreamedQuote]
+
 // MCPService_ServiceDesc is the grpc.ServiceDesc for MCPService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -362,6 +402,12 @@ var MCPService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _MCPService_ReadResource_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamQuotes",
+			Handler:       _MCPService_StreamQuotes_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "mcp.proto",
 }
@@ -0,0 +1,120 @@
+package quotify
+
+import (
+	"sync"
+)
+
+// Bag deals quotes from a Quotify without repeats ("shuffle bag" mode):
+// every quote is served once before any quote is served again, and a new
+// round never starts with the quote that ended the previous one. With
+// uniqueAuthors set, authors are dealt the same way (authentic quotes always
+// keep their real author). Weights still apply within a round, so heavier
+// entries tend to come out earlier.
+//
+// A Bag is safe for concurrent use.
+type Bag struct {
+	q             *Quotify
+	uniqueAuthors bool
+
+	mu      sync.Mutex
+	quotes  deck
+	authors deck
+}
+
+// deck tracks which entries have been dealt in the current round.
+type deck struct {
+	used []bool
+	last int
+}
+
+// NewBag returns an empty shuffle bag over q's corpus.
+func (q *Quotify) NewBag(uniqueAuthors bool) *Bag {
+	return &Bag{
+		q:             q,
+		uniqueAuthors: uniqueAuthors,
+		quotes:        deck{used: make([]bool, len(q.Quotes)), last: -1},
+		authors:       deck{used: make([]bool, len(q.Authors)), last: -1},
+	}
+}
+
+// Quotify returns the instance the bag deals from.
+func (b *Bag) Quotify() *Quotify {
+	return b.q
+}
+
+// Next deals the next quote.
+func (b *Bag) Next() Quote {
+	quote, _ := b.NextFiltered(Filter{})
+	return quote
+}
+
+// NextFiltered deals the next quote among the entries matching f. Rounds are
+// tracked per entry, so a quote served under one filter counts as used for
+// every other filter too. It returns an error wrapping ErrNoMatch if no
+// quote or no author qualifies.
+func (b *Bag) NextFiltered(f Filter) (Quote, error) {
+	q := b.q
+	quotes := q.matchingQuotes(f)
+	if len(quotes) == 0 {
+		return Quote{}, f.noQuotes()
+	}
+	var authors []int
+	if !f.Authentic {
+		if authors = q.matchingAuthors(f.AuthorTags); len(authors) == 0 {
+			return Quote{}, f.noAuthors()
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	quotes = b.quotes.remaining(quotes)
+	if b.uniqueAuthors && !f.Authentic {
+		authors = b.authors.remaining(authors)
+	}
+
+	q.mu.Lock()
+	qi := q.newQuoteSampler(quotes).pick(q.random())
+	ai := -1
+	if !f.Authentic {
+		ai = q.newAuthorSampler(authors).pick(q.random())
+	}
+	q.mu.Unlock()
+
+	b.quotes.deal(qi)
+	if f.Authentic {
+		return q.attribute(q.Quotes[qi]), nil
+	}
+	if b.uniqueAuthors {
+		b.authors.deal(ai)
+	}
+	return q.pair(q.Quotes[qi], q.Authors[ai]), nil
+}
+
+// remaining returns the entries of candidates not yet dealt this round. If
+// all of them have been dealt, it starts a new round for those entries,
+// leaving out the one dealt last so it cannot come up twice in a row.
+func (d *deck) remaining(candidates []int) []int {
+	var left []int
+	for _, i := range candidates {
+		if !d.used[i] {
+			left = append(left, i)
+		}
+	}
+	if len(left) > 0 {
+		return left
+	}
+	for _, i := range candidates {
+		d.used[i] = false
+		if i != d.last || len(candidates) == 1 {
+			left = append(left, i)
+		}
+	}
+	return left
+}
+
+// deal marks entry i as served.
+func (d *deck) deal(i int) {
+	d.used[i] = true
+	d.last = i
+}
@@ -0,0 +1,38 @@
+package quotify
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBagNoRepeats(t *testing.T) {
+	q := newTestQuotify(t, 1)
+	bag := q.NewBag(true)
+	const rounds = 5
+	quotes := make([]string, rounds*len(q.Quotes))
+	authors := make([]string, len(quotes))
+	for i := range quotes {
+		quote := bag.Next()
+		quotes[i], authors[i] = quote.Text, quote.Author
+	}
+
+	// Every quote, and every author, is dealt once per round, and a round
+	// never starts with the entry that ended the one before.
+	checkRounds := func(kind string, dealt []string, n int) {
+		t.Helper()
+		for start := 0; start+n <= len(dealt); start += n {
+			round := slices.Clone(dealt[start : start+n])
+			slices.Sort(round)
+			if len(slices.Compact(round)) != n {
+				t.Errorf("%s round %v repeats an entry", kind, dealt[start:start+n])
+			}
+		}
+		for i := 1; i < len(dealt); i++ {
+			if dealt[i] == dealt[i-1] {
+				t.Errorf("%s %q dealt twice in a row", kind, dealt[i])
+			}
+		}
+	}
+	checkRounds("quote", quotes, len(q.Quotes))
+	checkRounds("author", authors, len(q.Authors))
+}
@@ -0,0 +1,174 @@
+package quotify
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// ErrBatchTooLarge is returned by GenerateN when the corpus cannot supply
+// the requested number of quotes under the requested uniqueness rules.
+var ErrBatchTooLarge = errors.New("batch too large for corpus")
+
+// BatchOptions configures GenerateN. The uniqueness flags may be combined.
+type BatchOptions struct {
+	Filter
+
+	// UniqueQuotes forbids the same quote text appearing twice.
+	UniqueQuotes bool
+	// UniqueAuthors forbids the same author appearing twice.
+	UniqueAuthors bool
+	// UniquePairs forbids the same quote and author combination appearing
+	// twice, while allowing either to repeat on its own.
+	UniquePairs bool
+}
+
+// GenerateN returns n quotes in one go, honouring the filter and uniqueness
+// rules in opts. It returns an error wrapping ErrBatchTooLarge if the
+// matching corpus is too small for n, or wrapping ErrNoMatch if nothing
+// matches at all.
+func (q *Quotify) GenerateN(n int, opts BatchOptions) ([]Quote, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("invalid batch size %d", n)
+	}
+	quotes := q.matchingQuotes(opts.Filter)
+	if len(quotes) == 0 {
+		return nil, opts.noQuotes()
+	}
+	if opts.Authentic {
+		return q.generateAuthenticN(n, quotes, opts)
+	}
+	authors := q.matchingAuthors(opts.AuthorTags)
+	if len(authors) == 0 {
+		return nil, opts.noAuthors()
+	}
+
+	if opts.UniqueQuotes && n > len(quotes) {
+		return nil, fmt.Errorf("%w: asked for %d unique quotes but only %d match", ErrBatchTooLarge, n, len(quotes))
+	}
+	if opts.UniqueAuthors && n > len(authors) {
+		return nil, fmt.Errorf("%w: asked for %d unique authors but only %d match", ErrBatchTooLarge, n, len(authors))
+	}
+	pairs := len(quotes) * len(authors)
+	if opts.UniquePairs && n > pairs {
+		return nil, fmt.Errorf("%w: asked for %d unique pairs but only %d are possible", ErrBatchTooLarge, n, pairs)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	rng := q.random()
+	quoteWeight := func(i int) float64 { return q.Quotes[i].EffectiveWeight() }
+	authorWeight := func(i int) float64 { return q.Authors[i].EffectiveWeight() }
+
+	var qs, as []int
+	switch {
+	case opts.UniquePairs && !opts.UniqueQuotes && !opts.UniqueAuthors && 2*n > pairs:
+		// Too dense for rejection sampling: order every possible pair.
+		all := make([]int, pairs)
+		for i := range all {
+			all[i] = i
+		}
+		pairWeight := func(p int) float64 {
+			return quoteWeight(quotes[p/len(authors)]) * authorWeight(authors[p%len(authors)])
+		}
+		for _, p := range weightedPerm(rng, all, pairWeight)[:n] {
+			qs = append(qs, quotes[p/len(authors)])
+			as = append(as, authors[p%len(authors)])
+		}
+	default:
+		qs = draw(rng, quotes, quoteWeight, n, opts.UniqueQuotes)
+		as = draw(rng, authors, authorWeight, n, opts.UniqueAuthors)
+		if opts.UniquePairs && !opts.UniqueQuotes && !opts.UniqueAuthors {
+			// At most half of all pairs are wanted, so redrawing clashes
+			// terminates quickly.
+			quoteSampler := newSampler(quotes, quoteWeight)
+			authorSampler := newSampler(authors, authorWeight)
+			seen := make(map[[2]int]bool, n)
+			for i := range qs {
+				for seen[[2]int{qs[i], as[i]}] {
+					qs[i], as[i] = quoteSampler.pick(rng), authorSampler.pick(rng)
+				}
+				seen[[2]int{qs[i], as[i]}] = true
+			}
+		}
+	}
+
+	batch := make([]Quote, n)
+	for i := range batch {
+		batch[i] = q.pair(q.Quotes[qs[i]], q.Authors[as[i]])
+	}
+	return batch, nil
+}
+
+// generateAuthenticN is GenerateN for authentic filters, where each quote
+// comes with its real author so pairs are the same as quotes.
+func (q *Quotify) generateAuthenticN(n int, quotes []int, opts BatchOptions) ([]Quote, error) {
+	if (opts.UniqueQuotes || opts.UniquePairs) && n > len(quotes) {
+		return nil, fmt.Errorf("%w: asked for %d unique quotes but only %d match", ErrBatchTooLarge, n, len(quotes))
+	}
+	if opts.UniqueAuthors {
+		distinct := make(map[string]bool)
+		for _, i := range quotes {
+			distinct[q.Quotes[i].Author] = true
+		}
+		if n > len(distinct) {
+			return nil, fmt.Errorf("%w: asked for %d unique authors but only %d match", ErrBatchTooLarge, n, len(distinct))
+		}
+	}
+
+	q.mu.Lock()
+	rng := q.random()
+	weight := func(i int) float64 { return q.Quotes[i].EffectiveWeight() }
+	var picked []int
+	if opts.UniqueAuthors {
+		// Walk a weighted random order, keeping each author's first quote.
+		seen := make(map[string]bool, n)
+		for _, i := range weightedPerm(rng, quotes, weight) {
+			if len(picked) == n {
+				break
+			}
+			if author := q.Quotes[i].Author; !seen[author] {
+				seen[author] = true
+				picked = append(picked, i)
+			}
+		}
+	} else {
+		picked = draw(rng, quotes, weight, n, opts.UniqueQuotes || opts.UniquePairs)
+	}
+	q.mu.Unlock()
+
+	batch := make([]Quote, n)
+	for i, qi := range picked {
+		batch[i] = q.attribute(q.Quotes[qi])
+	}
+	return batch, nil
+}
+
+// draw picks n of indices by weight, with or without replacement. Without
+// replacement, n must not exceed len(indices).
+func draw(rng *rand.Rand, indices []int, weight func(int) float64, n int, unique bool) []int {
+	if unique {
+		return weightedPerm(rng, indices, weight)[:n]
+	}
+	s := newSampler(indices, weight)
+	out := make([]int, n)
+	for i := range out {
+		out[i] = s.pick(rng)
+	}
+	return out
+}
+
+// weightedPerm returns indices in a random order where heavier entries tend
+// to come first; any prefix is a weighted sample without replacement
+// (Efraimidis-Spirakis).
+func weightedPerm(rng *rand.Rand, indices []int, weight func(int) float64) []int {
+	keys := make(map[int]float64, len(indices))
+	for _, i := range indices {
+		keys[i] = math.Pow(rng.Float64(), 1/weight(i))
+	}
+	perm := append([]int(nil), indices...)
+	sort.SliceStable(perm, func(a, b int) bool { return keys[perm[a]] > keys[perm[b]] })
+	return perm
+}
@@ -0,0 +1,70 @@
+package quotify
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// CardMIMEType is the MIME type of quote cards.
+const CardMIMEType = "image/svg+xml"
+
+// Quote card layout, in SVG user units. Lines are wrapped by character
+// count, which is close enough for the serif font the cards use.
+const (
+	cardWidth      = 800
+	cardMargin     = 60
+	cardLineHeight = 44
+	cardLineChars  = 34
+	cardMaxLines   = 8
+)
+
+// Card renders quote as an SVG quote card: the text in large serif type,
+// wrapped to fit, followed by the author and source.
+func (quote Quote) Card() []byte {
+	lines := wrap(quote.Text, cardLineChars)
+	if len(lines) > cardMaxLines {
+		lines = lines[:cardMaxLines]
+		lines[cardMaxLines-1] += "…"
+	}
+	lines[0] = "“" + lines[0]
+	lines[len(lines)-1] += "”"
+
+	credit := "— " + quote.Author
+	if quote.Source != "" {
+		credit += ", " + quote.Source
+	}
+	height := 2*cardMargin + (len(lines)+1)*cardLineHeight + cardLineHeight/2
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", cardWidth, height, cardWidth, height)
+	fmt.Fprintf(&b, "  <rect width=\"100%%\" height=\"100%%\" rx=\"24\" fill=\"#1d1b2f\"/>\n")
+	fmt.Fprintf(&b, "  <text font-family=\"Georgia, serif\" font-size=\"34\" fill=\"#f5f0e6\">\n")
+	for i, line := range lines {
+		fmt.Fprintf(&b, "    <tspan x=\"%d\" y=\"%d\">%s</tspan>\n", cardMargin, cardMargin+(i+1)*cardLineHeight, html.EscapeString(line))
+	}
+	fmt.Fprintf(&b, "  </text>\n")
+	fmt.Fprintf(&b, "  <text x=\"%d\" y=\"%d\" text-anchor=\"end\" font-family=\"Georgia, serif\" font-size=\"24\" font-style=\"italic\" fill=\"#ff69b4\">%s</text>\n",
+		cardWidth-cardMargin, height-cardMargin, html.EscapeString(credit))
+	fmt.Fprintf(&b, "</svg>\n")
+	return b.Bytes()
+}
+
+// wrap splits text into lines of at most width characters, breaking
+// between words. Words longer than width get a line of their own.
+func wrap(text string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	for _, word := range strings.Fields(text) {
+		if line.Len() > 0 && len([]rune(line.String()))+1+len([]rune(word)) > width {
+			lines = append(lines, line.String())
+			line.Reset()
+		}
+		if line.Len() > 0 {
+			line.WriteByte(' ')
+		}
+		line.WriteString(word)
+	}
+	return append(lines, line.String())
+}
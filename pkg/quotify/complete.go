@@ -0,0 +1,133 @@
+package quotify
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// AuthorNames returns the names of every author in the corpus, including
+// real authors of quotes that are not listed as authors, sorted and without
+// duplicates.
+func (q *Quotify) AuthorNames() []string {
+	seen := make(map[string]bool)
+	for _, a := range q.Authors {
+		seen[a.Name] = true
+	}
+	for _, e := range q.Quotes {
+		if e.Author != "" {
+			seen[e.Author] = true
+		}
+	}
+	return sortedKeys(seen)
+}
+
+// Tags returns every tag used by a quote or an author, sorted and without
+// duplicates.
+func (q *Quotify) Tags() []string {
+	seen := make(map[string]bool)
+	for _, a := range q.Authors {
+		for _, t := range a.Tags {
+			seen[t] = true
+		}
+	}
+	for _, e := range q.Quotes {
+		for _, t := range e.Tags {
+			seen[t] = true
+		}
+	}
+	return sortedKeys(seen)
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Completion match ranks, best first.
+const (
+	matchPrefix = iota
+	matchWordPrefix
+	matchSubstring
+	matchFuzzy
+	noMatch
+)
+
+// Complete returns the candidates that match partial, ignoring case, best
+// matches first: candidates starting with partial, then those with a word
+// starting with it ("yoda" finds "Master Yoda"), then those containing it,
+// and finally those containing its letters in order ("mstyd" finds "Master
+// Yoda"). Candidates of equal rank keep their order. An empty partial
+// matches everything.
+func Complete(candidates []string, partial string) []string {
+	partial = strings.ToLower(strings.TrimSpace(partial))
+	type match struct {
+		value string
+		rank  int
+	}
+	var matches []match
+	for _, c := range candidates {
+		if rank := matchRank(strings.ToLower(c), partial); rank != noMatch {
+			matches = append(matches, match{c, rank})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].rank < matches[j].rank })
+
+	values := make([]string, len(matches))
+	for i, m := range matches {
+		values[i] = m.value
+	}
+	return values
+}
+
+// matchRank ranks how well the lower-cased candidate c matches partial.
+func matchRank(c, partial string) int {
+	switch {
+	case strings.HasPrefix(c, partial):
+		return matchPrefix
+	case hasWordPrefix(c, partial):
+		return matchWordPrefix
+	case strings.Contains(c, partial):
+		return matchSubstring
+	case isSubsequence(c, partial):
+		return matchFuzzy
+	default:
+		return noMatch
+	}
+}
+
+// hasWordPrefix reports whether a word of c other than the first starts with
+// partial.
+func hasWordPrefix(c, partial string) bool {
+	for i, r := range c {
+		if i > 0 && !isWordRune(r) {
+			if strings.HasPrefix(c[i+len(string(r)):], partial) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isSubsequence reports whether the non-space runes of partial appear in c
+// in order.
+func isSubsequence(c, partial string) bool {
+	rest := []rune(strings.ReplaceAll(partial, " ", ""))
+	for _, r := range c {
+		if len(rest) == 0 {
+			break
+		}
+		if r == rest[0] {
+			rest = rest[1:]
+		}
+	}
+	return len(rest) == 0
+}
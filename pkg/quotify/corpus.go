@@ -0,0 +1,413 @@
+package quotify
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"embed"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed corpus/default.json
+var defaultCorpusFS embed.FS
+
+// Corpus is the on-disk representation of a quote pack.
+type Corpus struct {
+	Authors []AuthorEntry `json:"authors" yaml:"authors"`
+	Quotes  []QuoteEntry  `json:"quotes" yaml:"quotes"`
+}
+
+// QuoteEntry is a quote in a corpus. In JSON and YAML it may be written either
+// as a plain string or as an object with "id", "text", "author", "source",
+// "tags" and "weight".
+//
+// ID optionally names the quote; when empty it is derived from the text (see
+// EffectiveID). Author and Source optionally record who really said the
+// quote and where; they are used for authentic attribution. Weight sets how
+// likely the entry is to be picked relative to the others; zero (the
+// default) counts as 1.
+type QuoteEntry struct {
+	ID     string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Text   string   `json:"text" yaml:"text"`
+	Author string   `json:"author,omitempty" yaml:"author,omitempty"`
+	Source string   `json:"source,omitempty" yaml:"source,omitempty"`
+	Tags   []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Weight float64  `json:"weight,omitempty" yaml:"weight,omitempty"`
+}
+
+// AuthorEntry is an author in a corpus. In JSON and YAML it may be written
+// either as a plain string or as an object with "name", "tags" and "weight".
+type AuthorEntry struct {
+	Name   string   `json:"name" yaml:"name"`
+	Tags   []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Weight float64  `json:"weight,omitempty" yaml:"weight,omitempty"`
+}
+
+// EffectiveWeight returns the entry's selection weight, treating zero as 1.
+func (e QuoteEntry) EffectiveWeight() float64 {
+	return effectiveWeight(e.Weight)
+}
+
+// EffectiveWeight returns the entry's selection weight, treating zero as 1.
+func (e AuthorEntry) EffectiveWeight() float64 {
+	return effectiveWeight(e.Weight)
+}
+
+// EffectiveID returns the entry's ID, or if it has none a short hash of its
+// text, so that quotes keep the same ID across reloads and reorderings.
+func (e QuoteEntry) EffectiveID() string {
+	if e.ID != "" {
+		return e.ID
+	}
+	sum := sha1.Sum([]byte(e.Text))
+	return hex.EncodeToString(sum[:4])
+}
+
+func effectiveWeight(w float64) float64 {
+	if w == 0 {
+		return 1
+	}
+	return w
+}
+
+func (e *QuoteEntry) UnmarshalJSON(data []byte) error {
+	type plain QuoteEntry
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &e.Text)
+	}
+	return json.Unmarshal(data, (*plain)(e))
+}
+
+func (e *QuoteEntry) UnmarshalYAML(node *yaml.Node) error {
+	type plain QuoteEntry
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&e.Text)
+	}
+	return node.Decode((*plain)(e))
+}
+
+func (e *AuthorEntry) UnmarshalJSON(data []byte) error {
+	type plain AuthorEntry
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &e.Name)
+	}
+	return json.Unmarshal(data, (*plain)(e))
+}
+
+func (e *AuthorEntry) UnmarshalYAML(node *yaml.Node) error {
+	type plain AuthorEntry
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&e.Name)
+	}
+	return node.Decode((*plain)(e))
+}
+
+// Supported corpus file formats.
+const (
+	CorpusJSON = "json"
+	CorpusYAML = "yaml"
+	CorpusCSV  = "csv"
+)
+
+// DefaultCorpus returns a fresh copy of the built-in corpus.
+func DefaultCorpus() *Corpus {
+	data, err := defaultCorpusFS.ReadFile("corpus/default.json")
+	if err != nil {
+		panic(fmt.Sprintf("quotify: reading embedded corpus: %v", err))
+	}
+	c, err := ParseCorpus(bytes.NewReader(data), CorpusJSON)
+	if err != nil {
+		panic(fmt.Sprintf("quotify: parsing embedded corpus: %v", err))
+	}
+	return c
+}
+
+// CorpusFormatForPath returns the corpus format implied by the extension of
+// path, or "" if the extension is not recognised.
+func CorpusFormatForPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return CorpusJSON
+	case ".yaml", ".yml":
+		return CorpusYAML
+	case ".csv":
+		return CorpusCSV
+	default:
+		return ""
+	}
+}
+
+// ParseCorpus decodes a corpus in the given format from r.
+//
+// CSV corpora must start with a header row containing a "kind" column
+// ("author" or "quote") and a "text" column. An optional "tags" column holds
+// semicolon-separated tags and an optional "weight" column holds the entry
+// weight. Quote rows may also fill optional "id", "author" and "source"
+// columns. Other columns are ignored.
+//
+// Tags are normalised to lower case.
+func ParseCorpus(r io.Reader, format string) (*Corpus, error) {
+	c := &Corpus{}
+	switch format {
+	case CorpusJSON:
+		if err := json.NewDecoder(r).Decode(c); err != nil {
+			return nil, fmt.Errorf("decoding JSON corpus: %w", err)
+		}
+	case CorpusYAML:
+		if err := yaml.NewDecoder(r).Decode(c); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("decoding YAML corpus: %w", err)
+		}
+	case CorpusCSV:
+		if err := parseCSV(r, c); err != nil {
+			return nil, fmt.Errorf("decoding CSV corpus: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported corpus format %q", format)
+	}
+	for i := range c.Authors {
+		c.Authors[i].Tags = normalizeTags(c.Authors[i].Tags)
+	}
+	for i := range c.Quotes {
+		c.Quotes[i].Tags = normalizeTags(c.Quotes[i].Tags)
+	}
+	return c, nil
+}
+
+func parseCSV(r io.Reader, c *Corpus) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("reading header: %w", err)
+	}
+	cols := make(map[string]int)
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	kindCol, ok := cols["kind"]
+	if !ok {
+		return errors.New(`header is missing a "kind" column`)
+	}
+	textCol, ok := cols["text"]
+	if !ok {
+		return errors.New(`header is missing a "text" column`)
+	}
+	column := func(record []string, name string) string {
+		if i, ok := cols[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := cr.FieldPos(0)
+		if kindCol >= len(record) || textCol >= len(record) {
+			return fmt.Errorf("line %d: missing kind or text", line)
+		}
+		text := record[textCol]
+		var tags []string
+		if v := column(record, "tags"); v != "" {
+			tags = strings.Split(v, ";")
+		}
+		var weight float64
+		if v := column(record, "weight"); v != "" {
+			weight, err = strconv.ParseFloat(v, 64)
+			if err != nil {
+				return fmt.Errorf("line %d: invalid weight: %w", line, err)
+			}
+		}
+		switch strings.ToLower(strings.TrimSpace(record[kindCol])) {
+		case "author":
+			c.Authors = append(c.Authors, AuthorEntry{Name: text, Tags: tags, Weight: weight})
+		case "quote":
+			c.Quotes = append(c.Quotes, QuoteEntry{
+				ID:     column(record, "id"),
+				Text:   text,
+				Author: column(record, "author"),
+				Source: column(record, "source"),
+				Tags:   tags,
+				Weight: weight,
+			})
+		default:
+			return fmt.Errorf("line %d: unknown kind %q (want author or quote)", line, record[kindCol])
+		}
+	}
+}
+
+// LoadCorpus reads a corpus from path. If path is a directory, every JSON,
+// YAML and CSV file directly inside it is loaded in lexical order. Either
+// way, the entries are merged as by Merge, so that an author or quote
+// listed twice, whether in one file or in two, appears once.
+func LoadCorpus(path string) (*Corpus, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if info.IsDir() {
+		if files, err = CorpusFiles(path); err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no corpus files found in %s", path)
+		}
+	}
+
+	merged := &Corpus{}
+	for _, file := range files {
+		c, err := loadCorpusFile(file)
+		if err != nil {
+			return nil, err
+		}
+		merged.Merge(c)
+	}
+	return merged, nil
+}
+
+// CorpusFiles lists the corpus files directly inside dir, sorted by name.
+func CorpusFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		if e.IsDir() || CorpusFormatForPath(e.Name()) == "" {
+			continue
+		}
+		files = append(files, filepath.Join(dir, e.Name()))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func loadCorpusFile(path string) (*Corpus, error) {
+	format := CorpusFormatForPath(path)
+	if format == "" {
+		return nil, fmt.Errorf("%s: unrecognised corpus file extension", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	c, err := ParseCorpus(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Merge appends the authors and quotes of other to c. Entries that c already
+// contains (by name or text) are not duplicated; their tags are combined and
+// a missing ID or real attribution is filled in from other.
+func (c *Corpus) Merge(other *Corpus) {
+	authors := make(map[string]int, len(c.Authors))
+	for i, a := range c.Authors {
+		authors[a.Name] = i
+	}
+	for _, a := range other.Authors {
+		if i, ok := authors[a.Name]; ok {
+			c.Authors[i].Tags = normalizeTags(append(c.Authors[i].Tags, a.Tags...))
+			continue
+		}
+		authors[a.Name] = len(c.Authors)
+		c.Authors = append(c.Authors, a)
+	}
+
+	quotes := make(map[string]int, len(c.Quotes))
+	for i, q := range c.Quotes {
+		quotes[q.Text] = i
+	}
+	for _, q := range other.Quotes {
+		if i, ok := quotes[q.Text]; ok {
+			c.Quotes[i].Tags = normalizeTags(append(c.Quotes[i].Tags, q.Tags...))
+			if c.Quotes[i].Author == "" {
+				c.Quotes[i].Author, c.Quotes[i].Source = q.Author, q.Source
+			}
+			if c.Quotes[i].ID == "" {
+				c.Quotes[i].ID = q.ID
+			}
+			continue
+		}
+		quotes[q.Text] = len(c.Quotes)
+		c.Quotes = append(c.Quotes, q)
+	}
+}
+
+// Validate reports whether c can be used to generate quotes.
+func (c *Corpus) Validate() error {
+	if len(c.Authors) == 0 {
+		return errors.New("corpus has no authors")
+	}
+	if len(c.Quotes) == 0 {
+		return errors.New("corpus has no quotes")
+	}
+	for i, a := range c.Authors {
+		if strings.TrimSpace(a.Name) == "" {
+			return fmt.Errorf("author %d has no name", i)
+		}
+		if err := validateWeight(a.Weight); err != nil {
+			return fmt.Errorf("author %q: %w", a.Name, err)
+		}
+	}
+	ids := make(map[string]string, len(c.Quotes))
+	for i, q := range c.Quotes {
+		if strings.TrimSpace(q.Text) == "" {
+			return fmt.Errorf("quote %d has no text", i)
+		}
+		if err := validateWeight(q.Weight); err != nil {
+			return fmt.Errorf("quote %q: %w", q.Text, err)
+		}
+		id := q.EffectiveID()
+		if other, ok := ids[id]; ok {
+			return fmt.Errorf("quotes %q and %q share the ID %q", other, q.Text, id)
+		}
+		ids[id] = q.Text
+	}
+	return nil
+}
+
+func validateWeight(w float64) error {
+	if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+		return fmt.Errorf("invalid weight %v", w)
+	}
+	return nil
+}
+
+// normalizeTags lower-cases and trims tags, dropping empties and duplicates.
+func normalizeTags(tags []string) []string {
+	var out []string
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
@@ -0,0 +1,213 @@
+package quotify
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// wantParsed is the corpus every file in TestParseCorpus describes.
+var wantParsed = &Corpus{
+	Authors: []AuthorEntry{
+		{Name: "The Intern"},
+		{Name: "The CTO", Tags: []string{"tech"}, Weight: 2},
+	},
+	Quotes: []QuoteEntry{
+		{Text: "It works on my machine", Tags: []string{"tech", "excuses"}},
+		{ID: "back", Text: "I'll be back", Author: "The Terminator", Source: "The Terminator", Weight: 0.5},
+	},
+}
+
+func TestParseCorpus(t *testing.T) {
+	for format, data := range map[string]string{
+		CorpusJSON: `{
+			"authors": ["The Intern", {"name": "The CTO", "tags": ["Tech"], "weight": 2}],
+			"quotes": [
+				{"text": "It works on my machine", "tags": ["tech", " Excuses ", "TECH", ""]},
+				{"id": "back", "text": "I'll be back", "author": "The Terminator", "source": "The Terminator", "weight": 0.5}
+			]
+		}`,
+		CorpusYAML: `
+authors:
+  - The Intern
+  - name: The CTO
+    tags: [Tech]
+    weight: 2
+quotes:
+  - text: It works on my machine
+    tags: [tech, " Excuses ", TECH, ""]
+  - id: back
+    text: I'll be back
+    author: The Terminator
+    source: The Terminator
+    weight: 0.5
+`,
+		CorpusCSV: `Kind, Text, Tags, Weight, ID, Author, Source, Notes
+author,The Intern,,,,,,ignored
+author,The CTO,Tech,2
+quote,It works on my machine,tech; Excuses ;TECH;
+quote,I'll be back,,0.5,back,The Terminator,The Terminator
+`,
+	} {
+		c, err := ParseCorpus(strings.NewReader(data), format)
+		if err != nil {
+			t.Errorf("ParseCorpus(%s): %v", format, err)
+			continue
+		}
+		if !reflect.DeepEqual(c, wantParsed) {
+			t.Errorf("ParseCorpus(%s) = %+v, want %+v", format, c, wantParsed)
+		}
+	}
+}
+
+func TestParseCorpusErrors(t *testing.T) {
+	for _, tt := range []struct {
+		format, data string
+	}{
+		{CorpusJSON, `{"quotes": [`},
+		{CorpusJSON, `{"quotes": [1]}`},
+		{CorpusYAML, "quotes: [a, b"},
+		{CorpusCSV, ""},
+		{CorpusCSV, "text\nhello\n"},
+		{CorpusCSV, "kind\nquote\n"},
+		{CorpusCSV, "kind,text\nproverb,hello\n"},
+		{CorpusCSV, "kind,text,weight\nquote,hello,heavy\n"},
+		{CorpusCSV, "text,kind\nhello\n"},
+		{"toml", "quotes = []"},
+	} {
+		if c, err := ParseCorpus(strings.NewReader(tt.data), tt.format); err == nil {
+			t.Errorf("ParseCorpus(%s, %q) = %+v, want an error", tt.format, tt.data, c)
+		}
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	for _, tt := range []struct {
+		tags, want []string
+	}{
+		{nil, nil},
+		{[]string{"", " "}, nil},
+		{[]string{"Tech", " movies ", "TECH", "tech"}, []string{"tech", "movies"}},
+	} {
+		if got := normalizeTags(tt.tags); !slices.Equal(got, tt.want) {
+			t.Errorf("normalizeTags(%q) = %q, want %q", tt.tags, got, tt.want)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	c := &Corpus{
+		Authors: []AuthorEntry{{Name: "Yoda", Tags: []string{"movies"}}},
+		Quotes: []QuoteEntry{
+			{Text: "Do or do not", Tags: []string{"movies"}},
+			{ID: "mine", Text: "Hello world", Author: "Me"},
+		},
+	}
+	c.Merge(&Corpus{
+		Authors: []AuthorEntry{{Name: "Yoda", Tags: []string{"fiction", "movies"}}, {Name: "Lincoln"}},
+		Quotes: []QuoteEntry{
+			{ID: "yoda", Text: "Do or do not", Author: "Master Yoda", Source: "Empire", Tags: []string{"fiction"}},
+			{ID: "theirs", Text: "Hello world", Author: "Them"},
+			{Text: "Four score"},
+		},
+	})
+	want := &Corpus{
+		Authors: []AuthorEntry{{Name: "Yoda", Tags: []string{"movies", "fiction"}}, {Name: "Lincoln"}},
+		Quotes: []QuoteEntry{
+			{ID: "yoda", Text: "Do or do not", Author: "Master Yoda", Source: "Empire", Tags: []string{"movies", "fiction"}},
+			{ID: "mine", Text: "Hello world", Author: "Me"},
+			{Text: "Four score"},
+		},
+	}
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("merged corpus = %+v, want %+v", c, want)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *Corpus {
+		return &Corpus{
+			Authors: []AuthorEntry{{Name: "Yoda"}},
+			Quotes:  []QuoteEntry{{Text: "Do or do not"}, {Text: "Hello world"}},
+		}
+	}
+	if err := valid().Validate(); err != nil {
+		t.Fatalf("valid corpus: %v", err)
+	}
+	for name, breakIt := range map[string]func(c *Corpus){
+		"no authors":        func(c *Corpus) { c.Authors = nil },
+		"no quotes":         func(c *Corpus) { c.Quotes = nil },
+		"unnamed author":    func(c *Corpus) { c.Authors[0].Name = " " },
+		"empty quote":       func(c *Corpus) { c.Quotes[1].Text = "" },
+		"negative weight":   func(c *Corpus) { c.Quotes[0].Weight = -1 },
+		"NaN weight":        func(c *Corpus) { c.Authors[0].Weight = math.NaN() },
+		"infinite weight":   func(c *Corpus) { c.Quotes[0].Weight = math.Inf(1) },
+		"shared ID":         func(c *Corpus) { c.Quotes[0].ID, c.Quotes[1].ID = "x", "x" },
+		"ID clashes hash":   func(c *Corpus) { c.Quotes[1].ID = c.Quotes[0].EffectiveID() },
+		"same text, no IDs": func(c *Corpus) { c.Quotes[1].Text = c.Quotes[0].Text },
+	} {
+		c := valid()
+		breakIt(c)
+		if err := c.Validate(); err == nil {
+			t.Errorf("%s: Validate succeeded", name)
+		}
+	}
+}
+
+// TestLoadCorpusDuplicates checks that repeated entries are merged the same
+// way whether they are in one file or spread over a directory.
+func TestLoadCorpusDuplicates(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	single := write("single.yaml", `
+authors: [Yoda, Yoda]
+quotes:
+  - text: Do or do not
+    tags: [movies]
+  - text: Do or do not
+    tags: [fiction]
+`)
+	write("split/a.yaml", `
+authors: [Yoda]
+quotes:
+  - text: Do or do not
+    tags: [movies]
+`)
+	write("split/b.yaml", `
+authors: [Yoda]
+quotes:
+  - text: Do or do not
+    tags: [fiction]
+`)
+
+	want := &Corpus{
+		Authors: []AuthorEntry{{Name: "Yoda"}},
+		Quotes:  []QuoteEntry{{Text: "Do or do not", Tags: []string{"movies", "fiction"}}},
+	}
+	for _, path := range []string{single, filepath.Join(dir, "split")} {
+		c, err := LoadCorpus(path)
+		if err != nil {
+			t.Fatalf("LoadCorpus(%s): %v", path, err)
+		}
+		if !reflect.DeepEqual(c, want) {
+			t.Errorf("LoadCorpus(%s) = %+v, want %+v", path, c, want)
+		}
+		if err := c.Validate(); err != nil {
+			t.Errorf("LoadCorpus(%s): %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package quotify
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultSpacer separates quote and author in the text format.
+const DefaultSpacer = " - "
+
+// A Formatter renders a batch of quotes.
+type Formatter interface {
+	Format(w io.Writer, quotes []Quote) error
+}
+
+// FormatterFunc adapts a function to the Formatter interface.
+type FormatterFunc func(w io.Writer, quotes []Quote) error
+
+func (f FormatterFunc) Format(w io.Writer, quotes []Quote) error {
+	return f(w, quotes)
+}
+
+var (
+	formattersMu sync.RWMutex
+	formatters   = map[string]Formatter{
+		"text":     TextFormatter{Spacer: DefaultSpacer},
+		"json":     FormatterFunc(formatJSON),
+		"markdown": FormatterFunc(formatMarkdown),
+		"html":     FormatterFunc(formatHTML),
+		"yaml":     FormatterFunc(formatYAML),
+		"csv":      FormatterFunc(formatCSV),
+		"fortune":  FormatterFunc(formatFortune),
+	}
+)
+
+// RegisterFormatter makes f available under name, replacing any formatter
+// already registered under it.
+func RegisterFormatter(name string, f Formatter) {
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+	formatters[name] = f
+}
+
+// LookupFormatter returns the formatter registered under name.
+func LookupFormatter(name string) (Formatter, bool) {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	f, ok := formatters[name]
+	return f, ok
+}
+
+// FormatterNames returns the names of all registered formatters, sorted.
+func FormatterNames() []string {
+	formattersMu.RLock()
+	defer formattersMu.RUnlock()
+	names := make([]string, 0, len(formatters))
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Render formats quotes with the formatter registered under name.
+func Render(name string, quotes []Quote) (string, error) {
+	f, ok := LookupFormatter(name)
+	if !ok {
+		return "", fmt.Errorf("unknown format %q (want one of %s)", name, strings.Join(FormatterNames(), ", "))
+	}
+	var b strings.Builder
+	if err := f.Format(&b, quotes); err != nil {
+		return "", err
+	}
+	return strings.TrimRight(b.String(), "\n"), nil
+}
+
+// TextFormatter writes one "text<Spacer>author" line per quote.
+type TextFormatter struct {
+	Spacer string
+}
+
+func (f TextFormatter) Format(w io.Writer, quotes []Quote) error {
+	for _, q := range quotes {
+		if _, err := fmt.Fprintln(w, q.Text+f.Spacer+q.Author); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Limits on client-supplied templates, which must not be able to tie up
+// the server: the source and output sizes of a template formatter, and how
+// deeply its range actions may nest.
+const (
+	MaxTemplateSize   = 4 << 10
+	MaxTemplateOutput = 64 << 10
+	maxTemplateRanges = 2
+)
+
+// ErrTemplateOutputTooLarge is returned by a template formatter whose output
+// for a batch would exceed MaxTemplateOutput.
+var ErrTemplateOutputTooLarge = fmt.Errorf("template output exceeds %d bytes", MaxTemplateOutput)
+
+// NewTemplateFormatter returns a formatter that executes a text/template
+// once per quote, with the Quote as data, writing each result on its own
+// line. The template may use the functions upper, lower and join.
+//
+// Templates may come from clients, so they are limited to MaxTemplateSize
+// bytes and MaxTemplateOutput bytes of output per batch, may only range
+// over quote fields such as .Tags, and may not define or call templates.
+func NewTemplateFormatter(text string) (Formatter, error) {
+	if len(text) > MaxTemplateSize {
+		return nil, fmt.Errorf("template is longer than %d bytes", MaxTemplateSize)
+	}
+	tmpl, err := template.New("quote").Funcs(template.FuncMap{
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"join":  strings.Join,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	if len(tmpl.Templates()) > 1 {
+		return nil, errors.New("parsing template: templates may not define other templates")
+	}
+	if err := checkTemplate(tmpl.Tree.Root, 0); err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	return FormatterFunc(func(w io.Writer, quotes []Quote) error {
+		lw := &limitedWriter{w: w, n: MaxTemplateOutput}
+		for _, q := range quotes {
+			if err := tmpl.Execute(lw, q); err != nil {
+				if errors.Is(err, ErrTemplateOutputTooLarge) {
+					return ErrTemplateOutputTooLarge
+				}
+				return fmt.Errorf("executing template: %w", err)
+			}
+			if _, err := io.WriteString(lw, "\n"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// checkTemplate rejects the constructs that let a short template run for
+// arbitrarily long without writing anything: calls to other templates, and
+// ranges over anything but quote fields (such as integers) or nested more
+// than maxTemplateRanges deep.
+func checkTemplate(node parse.Node, ranges int) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkTemplate(child, ranges); err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		return checkBranch(&n.BranchNode, ranges)
+	case *parse.WithNode:
+		return checkBranch(&n.BranchNode, ranges)
+	case *parse.RangeNode:
+		if ranges == maxTemplateRanges {
+			return fmt.Errorf("range actions may nest at most %d deep", maxTemplateRanges)
+		}
+		if !isFieldPipe(n.Pipe) {
+			return fmt.Errorf("range over %s: templates may only range over quote fields such as .Tags", n.Pipe)
+		}
+		return checkBranch(&n.BranchNode, ranges+1)
+	case *parse.TemplateNode:
+		return errors.New("templates may not call other templates")
+	}
+	return nil
+}
+
+func checkBranch(n *parse.BranchNode, ranges int) error {
+	if err := checkTemplate(n.List, ranges); err != nil {
+		return err
+	}
+	return checkTemplate(n.ElseList, ranges)
+}
+
+// isFieldPipe reports whether pipe is a plain reference to a field of dot,
+// such as .Tags.
+func isFieldPipe(pipe *parse.PipeNode) bool {
+	if len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	_, ok := pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	return ok
+}
+
+// limitedWriter passes at most n bytes on to w, then fails with
+// ErrTemplateOutputTooLarge.
+type limitedWriter struct {
+	w io.Writer
+	n int
+}
+
+func (lw *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > lw.n {
+		return 0, ErrTemplateOutputTooLarge
+	}
+	n, err := lw.w.Write(p)
+	lw.n -= n
+	return n, err
+}
+
+// single returns the lone quote of a one-element batch, so that structured
+// formats keep the object shape for single quotes and use lists otherwise.
+func single(quotes []Quote) any {
+	if len(quotes) == 1 {
+		return quotes[0]
+	}
+	return quotes
+}
+
+func formatJSON(w io.Writer, quotes []Quote) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(single(quotes))
+}
+
+func formatYAML(w io.Writer, quotes []Quote) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(single(quotes)); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func formatMarkdown(w io.Writer, quotes []Quote) error {
+	for i, q := range quotes {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "> %s\n>\n> — *%s*\n", q.Text, q.Author); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatHTML(w io.Writer, quotes []Quote) error {
+	for _, q := range quotes {
+		_, err := fmt.Fprintf(w, "<blockquote class=\"quotify\">\n  <p>%s</p>\n  <footer>— <cite>%s</cite></footer>\n</blockquote>\n",
+			html.EscapeString(q.Text), html.EscapeString(q.Author))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatCSV(w io.Writer, quotes []Quote) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"id", "text", "author", "source", "tags", "author_tags", "weight", "author_weight"}); err != nil {
+		return err
+	}
+	for _, q := range quotes {
+		err := cw.Write([]string{
+			q.ID,
+			q.Text,
+			q.Author,
+			q.Source,
+			strings.Join(q.Tags, ";"),
+			strings.Join(q.AuthorTags, ";"),
+			strconv.FormatFloat(q.Weight, 'g', -1, 64),
+			strconv.FormatFloat(q.AuthorWeight, 'g', -1, 64),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatFortune writes quotes in the format read by the Unix fortune program.
+func formatFortune(w io.Writer, quotes []Quote) error {
+	for i, q := range quotes {
+		if i > 0 {
+			if _, err := io.WriteString(w, "%\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s\n\t\t-- %s\n", q.Text, q.Author); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package quotify
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTemplateFormatter(t *testing.T) {
+	f, err := NewTemplateFormatter(`{{upper .Author}}:{{range $i, $t := .Tags}} {{$i}}={{$t}}{{end}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := f.Format(&b, []Quote{{Author: "Yoda", Tags: []string{"movies", "fiction"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "YODA: 0=movies 1=fiction\n"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestTemplateFormatterRunawayOutput(t *testing.T) {
+	// Each quote stays under the limit, the batch does not.
+	f, err := NewTemplateFormatter(`{{.Text}}{{.Text}}{{.Text}}{{.Text}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	quotes := make([]Quote, 100)
+	for i := range quotes {
+		quotes[i].Text = strings.Repeat("x", 1000)
+	}
+	var b strings.Builder
+	if err := f.Format(&b, quotes); !errors.Is(err, ErrTemplateOutputTooLarge) {
+		t.Errorf("Format error = %v, want ErrTemplateOutputTooLarge", err)
+	}
+	if b.Len() > MaxTemplateOutput {
+		t.Errorf("wrote %d bytes, more than %d", b.Len(), MaxTemplateOutput)
+	}
+}
+
+func TestTemplateFormatterRejects(t *testing.T) {
+	for _, text := range []string{
+		`{{range 1000000000000}}x{{end}}`,
+		`{{range 3}}{{.}}{{end}}`,
+		`{{with 1000000000000}}{{range .}}x{{end}}{{end}}`,
+		`{{$n := 1000000000000}}{{range $n}}x{{end}}`,
+		`{{range len .Text}}x{{end}}`,
+		`{{range .Tags}}{{range .Tags}}{{range .Tags}}x{{end}}{{end}}{{end}}`,
+		`{{define "a"}}{{template "a" .}}{{end}}{{template "a" .}}`,
+		strings.Repeat("x", MaxTemplateSize+1),
+	} {
+		if _, err := NewTemplateFormatter(text); err == nil {
+			t.Errorf("NewTemplateFormatter(%.40q) succeeded", text)
+		}
+	}
+}
+
+func TestRunToolRunawayTemplate(t *testing.T) {
+	_, _, err := NewWithSeed(1).RunTool(ToolArgs{Format: "template", Template: `{{range 1000000000000}}x{{end}}`}, nil)
+	if err == nil {
+		t.Error("RunTool with a runaway range template succeeded")
+	}
+}
@@ -0,0 +1,161 @@
+package quotify
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PromptArg is an argument of a Prompt.
+type PromptArg struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+// Prompt is a quote-themed prompt template. Rendering it generates a fresh
+// quote and builds a single user message around it.
+type Prompt struct {
+	Name        string
+	Description string
+	Args        []PromptArg
+
+	// summary describes a rendered prompt; text builds its message from the
+	// arguments and the generated quote.
+	summary string
+	text    func(args map[string]string, quote string) string
+}
+
+// Arguments shared by the quote prompts.
+var (
+	topicArg = PromptArg{
+		Name:        "topic",
+		Description: "what the prompt is about; if it names a tag such as tech or movies, the quote is picked from that tag",
+	}
+	toneArg = PromptArg{
+		Name:        "tone",
+		Description: "tone of the response, e.g. enthusiastic, deadpan, sarcastic",
+	}
+	authorArg = PromptArg{
+		Name:        "author",
+		Description: "who the quote is credited to (default: a random author)",
+	}
+)
+
+// Prompts lists the quote prompts offered by the MCP servers.
+var Prompts = []Prompt{
+	{
+		Name:        "motivate_me",
+		Description: "A pep talk built around a freshly generated quote",
+		Args:        []PromptArg{topicArg, toneArg, authorArg},
+		summary:     "A pep talk built around a quote",
+		text: func(args map[string]string, quote string) string {
+			return fmt.Sprintf("I need some motivation for %s. Give me a short, %s pep talk built around this quote, taking the attribution completely seriously:\n\n%s",
+				argOr(args, "topic", "getting through today"), argOr(args, "tone", "enthusiastic"), quote)
+		},
+	},
+	{
+		Name:        "roast_with_quote",
+		Description: "A good-natured roast of someone or something, opening with a generated quote",
+		Args: []PromptArg{
+			{Name: "topic", Description: "who or what to roast", Required: true},
+			toneArg,
+			authorArg,
+		},
+		summary: "A good-natured roast anchored on a quote",
+		text: func(args map[string]string, quote string) string {
+			return fmt.Sprintf("Write a short, %s roast of %s. Keep it good-natured, and open with this quote as if it were written about them:\n\n%s",
+				argOr(args, "tone", "playful"), strings.TrimSpace(args["topic"]), quote)
+		},
+	},
+	{
+		Name:        "daily_standup_opener",
+		Description: "A short opener for the daily standup, starting with a generated quote",
+		Args:        []PromptArg{topicArg, toneArg, authorArg},
+		summary:     "A quote to kick off the daily standup",
+		text: func(args map[string]string, quote string) string {
+			return fmt.Sprintf("Write a two or three sentence, %s opener for our daily standup. Start with this quote and tie it to %s:\n\n%s",
+				argOr(args, "tone", "upbeat"), argOr(args, "topic", "the work ahead"), quote)
+		},
+	},
+	{
+		Name:        "explain_quote",
+		Description: "An explanation of a generated quote and its (possibly questionable) attribution",
+		Args:        []PromptArg{topicArg, toneArg, authorArg},
+		summary:     "An explanation of a quote and its attribution",
+		text: func(args map[string]string, quote string) string {
+			text := fmt.Sprintf("Explain what this quote means and why its author might have said it, in a %s tone", argOr(args, "tone", "thoughtful"))
+			if topic := strings.TrimSpace(args["topic"]); topic != "" {
+				text += fmt.Sprintf(", and how it applies to %s", topic)
+			}
+			return text + ":\n\n" + quote
+		},
+	},
+}
+
+// LookupPrompt returns the prompt with the given name.
+func LookupPrompt(name string) (Prompt, bool) {
+	for _, p := range Prompts {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Prompt{}, false
+}
+
+// MissingArgumentError is returned by Prompt.Render when a required
+// argument is missing.
+type MissingArgumentError struct {
+	Prompt string
+	Arg    PromptArg
+}
+
+func (e *MissingArgumentError) Error() string {
+	return fmt.Sprintf("%s needs a %s: %s", e.Prompt, e.Arg.Name, e.Arg.Description)
+}
+
+// Render generates a quote from q and returns a description of the prompt
+// and its message text. It fails with a *MissingArgumentError if a required
+// argument is missing.
+func (p Prompt) Render(q *Quotify, args map[string]string) (description, text string, err error) {
+	for _, a := range p.Args {
+		if a.Required && strings.TrimSpace(args[a.Name]) == "" {
+			return "", "", &MissingArgumentError{Prompt: p.Name, Arg: a}
+		}
+	}
+	quote, err := q.promptQuote(args)
+	if err != nil {
+		return "", "", err
+	}
+	return p.summary, p.text(args, quote), nil
+}
+
+// promptQuote generates the quote a prompt is built around. The topic
+// selects quotes by tag when it matches one, and author, if set, takes the
+// credit.
+func (q *Quotify) promptQuote(args map[string]string) (string, error) {
+	var filter Filter
+	if topic := strings.TrimSpace(args["topic"]); topic != "" {
+		filter.QuoteTags = []string{topic}
+	}
+	quote, err := q.GenerateFiltered(filter)
+	if errors.Is(err, ErrNoMatch) {
+		// Most topics are not tags; any quote will do.
+		quote, err = q.GenerateFiltered(Filter{})
+	}
+	if err != nil {
+		return "", err
+	}
+	if author := strings.TrimSpace(args["author"]); author != "" {
+		quote.Author = author
+	}
+	return fmt.Sprintf("\"%s\" - %s", quote.Text, quote.Author), nil
+}
+
+// argOr returns the named prompt argument, or def if it is unset.
+func argOr(args map[string]string, name, def string) string {
+	if v := strings.TrimSpace(args[name]); v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,247 @@
+package quotify
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrQuizOver is returned when answering a quiz that has no rounds left.
+var ErrQuizOver = errors.New("quiz is over")
+
+// Quiz limits.
+const (
+	DefaultQuizRounds  = 5
+	DefaultQuizChoices = 4
+	MaxQuizChoices     = 8
+)
+
+// Quiz is a multi-round "who really said it?" game. Each round shows an
+// authentically attributed quote and a shuffled list of candidate authors,
+// one of which is the real one; the others are drawn from Quotify.Authors.
+//
+// A Quiz is safe for concurrent use.
+type Quiz struct {
+	q       *Quotify
+	bag     *Bag
+	filter  Filter
+	rounds  int
+	choices int
+
+	mu       sync.Mutex
+	current  *QuizQuestion
+	answer   QuoteEntry
+	correct  int
+	answered int
+}
+
+// QuizQuestion is one round of a quiz.
+type QuizQuestion struct {
+	Round   int      `json:"round"`
+	Rounds  int      `json:"rounds"`
+	Text    string   `json:"text"`
+	Choices []string `json:"choices"`
+}
+
+// QuizResult is the outcome of answering a round.
+type QuizResult struct {
+	Correct bool      `json:"correct"`
+	Author  string    `json:"author"`
+	Source  string    `json:"source,omitempty"`
+	Score   QuizScore `json:"score"`
+
+	// Next is the following round, or nil once the quiz is finished.
+	Next *QuizQuestion `json:"next,omitempty"`
+}
+
+// QuizScore is the running score of a quiz.
+type QuizScore struct {
+	Correct  int  `json:"correct"`
+	Answered int  `json:"answered"`
+	Rounds   int  `json:"rounds"`
+	Finished bool `json:"finished"`
+}
+
+// NewQuiz starts a quiz of the given number of rounds, each offering the
+// given number of choices. Zero values select DefaultQuizRounds and
+// DefaultQuizChoices. Only authentic quotes matching f are asked, and no
+// quote is asked twice.
+func (q *Quotify) NewQuiz(rounds, choices int, f Filter) (*Quiz, error) {
+	if rounds == 0 {
+		rounds = DefaultQuizRounds
+	}
+	if choices == 0 {
+		choices = DefaultQuizChoices
+	}
+	if rounds < 0 {
+		return nil, fmt.Errorf("invalid number of rounds %d", rounds)
+	}
+	if choices < 2 || choices > MaxQuizChoices {
+		return nil, fmt.Errorf("number of choices must be between 2 and %d, got %d", MaxQuizChoices, choices)
+	}
+
+	f.Authentic = true
+	matching := q.matchingQuotes(f)
+	if n := len(matching); n == 0 {
+		return nil, f.noQuotes()
+	} else if n < rounds {
+		return nil, fmt.Errorf("only %d authentic quotes match, not enough for %d rounds", n, rounds)
+	}
+	// The other choices are drawn from the author list, which must hold
+	// enough names besides the answer for every quote that may be asked.
+	for _, i := range matching {
+		if distractors := q.distractors(q.Quotes[i].Author); distractors < choices-1 {
+			return nil, fmt.Errorf("corpus has only %d authors besides %s, not enough for %d choices", distractors, q.Quotes[i].Author, choices)
+		}
+	}
+
+	z := &Quiz{
+		q:       q,
+		bag:     q.NewBag(false),
+		filter:  f,
+		rounds:  rounds,
+		choices: choices,
+	}
+	if err := z.nextRound(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Question returns the current round, or nil once the quiz is finished.
+func (z *Quiz) Question() *QuizQuestion {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	return z.current
+}
+
+// Score returns the running score.
+func (z *Quiz) Score() QuizScore {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	return z.score()
+}
+
+// Answer checks guess against the current round and moves on to the next
+// one. A guess may be the author's name (case-insensitive), the letter of a
+// choice ("A", "b", ...) or its 1-based number.
+func (z *Quiz) Answer(guess string) (QuizResult, error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	if z.current == nil {
+		return QuizResult{}, ErrQuizOver
+	}
+	name, err := z.current.resolve(guess)
+	if err != nil {
+		return QuizResult{}, err
+	}
+
+	res := QuizResult{
+		Correct: strings.EqualFold(name, z.answer.Author),
+		Author:  z.answer.Author,
+		Source:  z.answer.Source,
+	}
+	z.answered++
+	if res.Correct {
+		z.correct++
+	}
+	if z.answered < z.rounds {
+		if err := z.nextRound(); err != nil {
+			return QuizResult{}, err
+		}
+	} else {
+		z.current = nil
+	}
+	res.Score = z.score()
+	res.Next = z.current
+	return res, nil
+}
+
+func (z *Quiz) score() QuizScore {
+	return QuizScore{
+		Correct:  z.correct,
+		Answered: z.answered,
+		Rounds:   z.rounds,
+		Finished: z.current == nil,
+	}
+}
+
+// nextRound draws a fresh quote and choices. Callers must hold z.mu, or own
+// z exclusively.
+func (z *Quiz) nextRound() error {
+	quote, err := z.bag.NextFiltered(z.filter)
+	if err != nil {
+		return err
+	}
+	entry := QuoteEntry{Text: quote.Text, Author: quote.Author, Source: quote.Source}
+
+	q := z.q
+	q.mu.Lock()
+	rng := q.random()
+	var choices []string
+	for _, i := range rng.Perm(len(q.Authors)) {
+		if len(choices) == z.choices-1 {
+			break
+		}
+		if name := q.Authors[i].Name; !strings.EqualFold(name, entry.Author) {
+			choices = append(choices, name)
+		}
+	}
+	at := rng.Intn(len(choices) + 1)
+	q.mu.Unlock()
+	if len(choices) < z.choices-1 {
+		return fmt.Errorf("corpus has only %d authors besides %s, not enough for %d choices", len(choices), entry.Author, z.choices)
+	}
+
+	choices = append(choices[:at], append([]string{entry.Author}, choices[at:]...)...)
+	z.answer = entry
+	z.current = &QuizQuestion{
+		Round:   z.answered + 1,
+		Rounds:  z.rounds,
+		Text:    entry.Text,
+		Choices: choices,
+	}
+	return nil
+}
+
+// resolve maps a guess to one of the question's choices.
+func (qq *QuizQuestion) resolve(guess string) (string, error) {
+	guess = strings.TrimSpace(guess)
+	if guess == "" {
+		return "", errors.New("empty answer")
+	}
+	for _, c := range qq.Choices {
+		if strings.EqualFold(c, guess) {
+			return c, nil
+		}
+	}
+	if len(guess) == 1 {
+		if i := int(strings.ToUpper(guess)[0] - 'A'); i >= 0 && i < len(qq.Choices) {
+			return qq.Choices[i], nil
+		}
+	}
+	if n, err := strconv.Atoi(guess); err == nil && n >= 1 && n <= len(qq.Choices) {
+		return qq.Choices[n-1], nil
+	}
+	return "", fmt.Errorf("%q is not one of the choices", guess)
+}
+
+// ChoiceLabel returns the letter used to refer to the i-th choice.
+func ChoiceLabel(i int) string {
+	return string(rune('A' + i))
+}
+
+// distractors returns the number of authors that can be offered as wrong
+// answers to a quote by author.
+func (q *Quotify) distractors(author string) int {
+	n := 0
+	for _, a := range q.Authors {
+		if !strings.EqualFold(a.Name, author) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,28 @@
+package quotify
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestNewQuizNeedsEnoughDistractors(t *testing.T) {
+	c := &Corpus{
+		Authors: []AuthorEntry{{Name: "A"}, {Name: "B"}, {Name: "C"}},
+		Quotes:  []QuoteEntry{{Text: "said by A", Author: "A"}},
+	}
+	q, err := NewFromCorpus(c, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := q.NewQuiz(1, 4, Filter{}); err == nil {
+		t.Error("NewQuiz with 4 choices and 2 other authors succeeded")
+	}
+	z, err := q.NewQuiz(1, 3, Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(z.Question().Choices); got != 3 {
+		t.Errorf("got %d choices, want 3", got)
+	}
+}
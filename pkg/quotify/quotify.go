@@ -1,138 +1,327 @@
 package quotify
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 )
 
+// ErrNoMatch is returned by GenerateFiltered when no quote or no author
+// satisfies the filter.
+var ErrNoMatch = errors.New("no match for filter")
+
 type Quote struct {
-	Text   string `json:"text"`
-	Author string `json:"author"`
+	// ID identifies the quote text within its corpus (see
+	// QuoteEntry.EffectiveID); it does not depend on the author.
+	ID         string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Text       string   `json:"text" yaml:"text"`
+	Author     string   `json:"author" yaml:"author"`
+	Tags       []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	AuthorTags []string `json:"author_tags,omitempty" yaml:"author_tags,omitempty"`
+
+	// Source is where an authentically attributed quote comes from, such as
+	// a film or a speech. It is empty for chaotic pairings.
+	Source string `json:"source,omitempty" yaml:"source,omitempty"`
+
+	// Weight and AuthorWeight are the selection weights of the chosen
+	// entries, reported for debugging corpus tuning.
+	Weight       float64 `json:"weight,omitempty" yaml:"weight,omitempty"`
+	AuthorWeight float64 `json:"author_weight,omitempty" yaml:"author_weight,omitempty"`
+}
+
+// Filter restricts which quotes and authors Generate may pick. Tags match
+// case-insensitively and an entry must carry every listed tag.
+type Filter struct {
+	QuoteTags  []string
+	AuthorTags []string
+
+	// Authentic limits generation to quotes whose real author is known and
+	// attributes them to that author instead of a random one. AuthorTags then
+	// apply to the real author, which must be listed in the corpus to match.
+	Authentic bool
+}
+
+// HiddenAuthor stands in for the author of a quote whose attribution is
+// being kept secret, as in a quiz.
+const HiddenAuthor = "???"
+
+// Hidden returns a copy of quote with everything that would give away its
+// author removed.
+func (quote Quote) Hidden() Quote {
+	quote.Author = HiddenAuthor
+	quote.AuthorTags = nil
+	quote.AuthorWeight = 0
+	quote.Source = ""
+	return quote
+}
+
+// noQuotes returns the error reported when no quote matches f.
+func (f Filter) noQuotes() error {
+	kind := "quotes"
+	if f.Authentic {
+		kind = "authentic quotes"
+	}
+	if len(f.QuoteTags) == 0 && len(f.AuthorTags) == 0 {
+		return fmt.Errorf("%w: no %s in corpus", ErrNoMatch, kind)
+	}
+	return fmt.Errorf("%w: no %s tagged %s", ErrNoMatch, kind, strings.Join(slices.Concat(f.QuoteTags, f.AuthorTags), ", "))
+}
+
+// noAuthors returns the error reported when no author matches f.
+func (f Filter) noAuthors() error {
+	return fmt.Errorf("%w: no authors tagged %s", ErrNoMatch, strings.Join(f.AuthorTags, ", "))
 }
 
 type Quotify struct {
-	Authors []string
-	Quotes  []string
+	Authors []AuthorEntry
+	Quotes  []QuoteEntry
 	Spacer  string
+
+	// mu guards rng, which is not safe for concurrent use on its own.
+	mu  sync.Mutex
+	rng *rand.Rand
+
+	// Weighted samplers over the whole corpus, built once by NewFromCorpus.
+	// Filtered generation builds its own over the matching entries.
+	quoteSampler, authorSampler *sampler
+
+	// index serves Search. It is guarded by mu, as it may be built lazily.
+	index *searchIndex
 }
 
+// New returns a Quotify with the default corpus, seeded from the current time.
 func New() *Quotify {
+	return NewWithSource(rand.NewSource(time.Now().UnixNano()))
+}
+
+// NewWithSeed returns a Quotify with the default corpus whose output is fully
+// determined by seed.
+func NewWithSeed(seed int64) *Quotify {
+	return NewWithSource(rand.NewSource(seed))
+}
+
+// NewWithSource returns a Quotify with the default corpus that draws its
+// randomness from src. The same source state always yields the same sequence
+// of quotes.
+func NewWithSource(src rand.Source) *Quotify {
+	q, err := NewFromCorpus(DefaultCorpus(), src)
+	if err != nil {
+		panic("quotify: invalid embedded corpus: " + err.Error())
+	}
+	return q
+}
+
+// NewFromCorpus returns a Quotify serving the entries of c. If src is nil the
+// instance is seeded from the current time.
+func NewFromCorpus(c *Corpus, src rand.Source) (*Quotify, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if src == nil {
+		src = rand.NewSource(time.Now().UnixNano())
+	}
+	q := &Quotify{
+		Authors: c.Authors,
+		Quotes:  c.Quotes,
+		Spacer:  DefaultSpacer,
+		rng:     rand.New(src),
+	}
+	q.quoteSampler = q.newQuoteSampler(allIndices(len(q.Quotes)))
+	q.authorSampler = q.newAuthorSampler(allIndices(len(q.Authors)))
+	q.index = newSearchIndex(q.Quotes)
+	return q, nil
+}
+
+// Load reads the corpus at path (a file or a directory of files, see
+// LoadCorpus) and returns a time-seeded Quotify serving it.
+func Load(path string) (*Quotify, error) {
+	c, err := LoadCorpus(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromCorpus(c, nil)
+}
+
+// WithSource returns a Quotify that shares q's corpus but draws its
+// randomness from src.
+func (q *Quotify) WithSource(src rand.Source) *Quotify {
 	return &Quotify{
-		Authors: []string{
-			"Ivanka Trump",
-			"Dog The Bounty Hunter",
-			"Master Yoda",
-			"Abe Lincoln",
-			"Stev-o",
-			"Wee-man",
-			"Albus Dumbledore",
-			"Satan",
-			"Momo Taleb",
-			"Undertaker",
-			"John Cena",
-			"Triple H",
-			"Kane",
-			"Big Show",
-			"The Rock",
-			"Bing Han",
-			"Sarah Palin",
-			"Dj Khaled",
-			"21 Savage",
-			"Sugar Ray Robinson",
-			"Soulja Boy",
-			"Betsy DeVos",
-			"The Red Power Ranger",
-			"Oprah Winfrey",
-			"Snoop Dogg",
-			"Olga",
-			"Logan Paul",
-			"Judge Tyco",
-			"Fred",
-		},
-		Quotes: []string{
-			"The ode lives upon the ideal, the epic upon the grandiose, the drama upon the real.",
-			"If we don't study the mistakes of the future we're doomed to repeat them for the first time.",
-			"C++ supports OOP",
-			"You can do anything, but not everything.",
-			"Perfection is achieved, not when there is nothing more to add, but when there is nothing left to take away.",
-			"The richest man is not he who has the most, but he who needs the least.",
-			"You miss 100 percent of the shots you never take.",
-			"Courage is not the absence of fear, but rather the judgement that something else is more important than fear.",
-			"You must be the change you wish to see in the world.",
-			"When hungry, eat your rice; when tired, close your eyes. Fools may laugh at me, but wise men will know what I mean.",
-			"To the man who only has a hammer, everything he encounters begins to look like a nail.",
-			"We are what we repeatedly do; excellence, then, is not an act but a habit.",
-			"The weak can never forgive. Forgiveness is the attribute of the strong.",
-			"Happiness is when what you think, what you say, and what you do are in harmony.",
-			"An eye for eye only ends up making the whole world blind.",
-			"Live as if you were to die tomorrow; learn as if you were to live forever.",
-			"First they ignore you, then they laugh at you, then they fight you, then you win.",
-			"You must not lose faith in humanity. Humanity is an ocean; if a few drops of the ocean are dirty, the ocean does not become dirty.",
-			"The best way to find yourself is to lose yourself in the service of others.",
-			"Strength does not come from physical capacity. It comes from an indomitable will.",
-			"A man is but the product of his thoughts; what he thinks, he becomes.",
-			"YES we can",
-			"No we can't",
-			"Those were alternative facts",
-			"You can't see me",
-			"I have a dream",
-			"In god we trust",
-			"Thou shall not pass",
-			"What is a private email server?",
-			"It's local on the the remote server",
-			"Don't ever play yourself",
-			"Just play. Have fun. Enjoy the game.",
-			"Being independent, being confident and having fun is what matters.",
-			"It's kind of fun to do the impossible.",
-			"We gucci Fam - Ghandi",
-			"I want my world to be fun.",
-			"Everyone gets a car",
-			"One more thing",
-			"Wrong",
-			"Bazinga!",
-			"You shall not pass!",
-			"Wingardium leviosa",
-			"Go bing or go home !",
-			"Chronological awareness.",
-			"This is a problem right here.",
-			"Oh my goodness..",
-			"www.loganpaul.com/shop",
-			"Get your merch on",
-			"Best merch in the game",
-			"Link in Bio",
-			"Be a maverick",
-			"DANG DAWG.",
-			"On the mandem level",
-			"you're born and then you die that's all there is to it",
-			"i think dreams are a socialist construct",
-			"The force is strong with this one",
-			"Winter is coming",
-			"May the odds be ever in your favor",
-			"With great power comes great responsibility",
-			"Life is like a box of chocolates",
-			"Elementary my dear Watson",
-			"Houston we have a problem",
-			"I'll be back",
-			"May the force be with you",
-			"Keep your friends close but your enemies closer",
-		},
-		Spacer: " - ",
+		Authors:       q.Authors,
+		Quotes:        q.Quotes,
+		Spacer:        q.Spacer,
+		rng:           rand.New(src),
+		quoteSampler:  q.quoteSampler,
+		authorSampler: q.authorSampler,
+		index:         q.searchIndex(),
 	}
 }
 
 func (q *Quotify) Generate() Quote {
-	rand.Seed(time.Now().UnixNano())
-	
-	randomQuote := q.Quotes[rand.Intn(len(q.Quotes))]
-	randomAuthor := q.Authors[rand.Intn(len(q.Authors))]
-	
+	quote, _ := q.GenerateFiltered(Filter{})
+	return quote
+}
+
+// GenerateFiltered pairs a random quote with a random author, considering
+// only the entries that match f. It returns an error wrapping ErrNoMatch if
+// no quote or no author qualifies.
+func (q *Quotify) GenerateFiltered(f Filter) (Quote, error) {
+	quotes := q.quoteSampler
+	if quotes == nil || len(f.QuoteTags) > 0 || f.Authentic {
+		quotes = q.newQuoteSampler(q.matchingQuotes(f))
+	}
+	if quotes.len() == 0 {
+		return Quote{}, f.noQuotes()
+	}
+	if f.Authentic {
+		q.mu.Lock()
+		quote := q.Quotes[quotes.pick(q.random())]
+		q.mu.Unlock()
+		return q.attribute(quote), nil
+	}
+
+	authors := q.authorSampler
+	if authors == nil || len(f.AuthorTags) > 0 {
+		authors = q.newAuthorSampler(q.matchingAuthors(f.AuthorTags))
+	}
+	if authors.len() == 0 {
+		return Quote{}, f.noAuthors()
+	}
+
+	q.mu.Lock()
+	quote := q.Quotes[quotes.pick(q.random())]
+	author := q.Authors[authors.pick(q.random())]
+	q.mu.Unlock()
+
+	return q.pair(quote, author), nil
+}
+
+// pair builds the Quote for a chosen quote and author entry.
+func (q *Quotify) pair(quote QuoteEntry, author AuthorEntry) Quote {
 	return Quote{
-		Text:   randomQuote,
-		Author: randomAuthor,
+		ID:           quote.EffectiveID(),
+		Text:         quote.Text,
+		Author:       author.Name,
+		Tags:         quote.Tags,
+		AuthorTags:   author.Tags,
+		Weight:       quote.EffectiveWeight(),
+		AuthorWeight: author.EffectiveWeight(),
+	}
+}
+
+// attribute builds the Quote for a quote credited to its real author.
+func (q *Quotify) attribute(quote QuoteEntry) Quote {
+	author, ok := q.Author(quote.Author)
+	if !ok {
+		author = AuthorEntry{Name: quote.Author}
+	}
+	out := q.pair(quote, author)
+	out.Source = quote.Source
+	return out
+}
+
+// Author returns the corpus entry for the named author.
+func (q *Quotify) Author(name string) (AuthorEntry, bool) {
+	for _, a := range q.Authors {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return AuthorEntry{}, false
+}
+
+// QuoteByID returns the corpus entry whose EffectiveID is id.
+func (q *Quotify) QuoteByID(id string) (QuoteEntry, bool) {
+	for _, e := range q.Quotes {
+		if e.EffectiveID() == id {
+			return e, true
+		}
+	}
+	return QuoteEntry{}, false
+}
+
+// QuotesBy returns the corpus entries really said by the named author.
+func (q *Quotify) QuotesBy(name string) []QuoteEntry {
+	var quotes []QuoteEntry
+	for _, e := range q.Quotes {
+		if e.Author == name {
+			quotes = append(quotes, e)
+		}
 	}
+	return quotes
+}
+
+// matchingQuotes returns the indices of the quotes that satisfy the quote
+// side of f, and for authentic filters the author side as well.
+func (q *Quotify) matchingQuotes(f Filter) []int {
+	var indices []int
+	for i, e := range q.Quotes {
+		if !hasAllTags(e.Tags, f.QuoteTags) {
+			continue
+		}
+		if f.Authentic {
+			if e.Author == "" {
+				continue
+			}
+			if len(f.AuthorTags) > 0 {
+				author, ok := q.Author(e.Author)
+				if !ok || !hasAllTags(author.Tags, f.AuthorTags) {
+					continue
+				}
+			}
+		}
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+// matchingAuthors returns the indices of the authors carrying all of tags.
+func (q *Quotify) matchingAuthors(tags []string) []int {
+	var indices []int
+	for i, e := range q.Authors {
+		if hasAllTags(e.Tags, tags) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+func (q *Quotify) newQuoteSampler(indices []int) *sampler {
+	return newSampler(indices, func(i int) float64 { return q.Quotes[i].EffectiveWeight() })
+}
+
+func (q *Quotify) newAuthorSampler(indices []int) *sampler {
+	return newSampler(indices, func(i int) float64 { return q.Authors[i].EffectiveWeight() })
 }
 
 func (q *Quotify) GenerateString() string {
-	quote := q.Generate()
+	return q.FormatText(q.Generate())
+}
+
+// FormatText renders quote as "text<Spacer>author".
+func (q *Quotify) FormatText(quote Quote) string {
 	return quote.Text + q.Spacer + quote.Author
+}
+
+// hasAllTags reports whether tags contains every entry of want, ignoring case.
+func hasAllTags(tags, want []string) bool {
+	for _, w := range want {
+		if !slices.Contains(tags, strings.ToLower(strings.TrimSpace(w))) {
+			return false
+		}
+	}
+	return true
+}
+
+// random returns the instance source. A Quotify built as a struct literal
+// has no source yet and gets a time-seeded one on first use. Callers must
+// hold q.mu.
+func (q *Quotify) random() *rand.Rand {
+	if q.rng == nil {
+		q.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return q.rng
 }
\ No newline at end of file
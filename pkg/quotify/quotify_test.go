@@ -0,0 +1,147 @@
+package quotify
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// testCorpus returns a small corpus with distinct tags on both sides.
+func testCorpus() *Corpus {
+	return &Corpus{
+		Authors: []AuthorEntry{
+			{Name: "Master Yoda", Tags: []string{"movies", "fiction"}},
+			{Name: "Abe Lincoln", Tags: []string{"politics", "history"}},
+			{Name: "Undertaker", Tags: []string{"wrestling"}},
+		},
+		Quotes: []QuoteEntry{
+			{Text: "Do or do not, there is no try", Author: "Master Yoda", Tags: []string{"movies"}},
+			{Text: "Four score and seven years ago", Author: "Abe Lincoln", Tags: []string{"politics", "history"}},
+			{Text: "Rest in peace", Tags: []string{"wrestling"}},
+			{Text: "Hello world", Tags: []string{"tech"}},
+		},
+	}
+}
+
+func newTestQuotify(t *testing.T, seed int64) *Quotify {
+	t.Helper()
+	q, err := NewFromCorpus(testCorpus(), rand.NewSource(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+func generateN(q *Quotify, n int) []Quote {
+	quotes := make([]Quote, n)
+	for i := range quotes {
+		quotes[i] = q.Generate()
+	}
+	return quotes
+}
+
+func TestNewWithSeedIsReproducible(t *testing.T) {
+	a := generateN(NewWithSeed(42), 20)
+	b := generateN(NewWithSeed(42), 20)
+	if !slices.EqualFunc(a, b, quotesEqual) {
+		t.Errorf("same seed gave different sequences:\n%v\n%v", a, b)
+	}
+
+	c := generateN(NewWithSeed(43), 20)
+	if slices.EqualFunc(a, c, quotesEqual) {
+		t.Errorf("seeds 42 and 43 gave the same sequence: %v", a)
+	}
+}
+
+func TestRunToolSeedIsReproducible(t *testing.T) {
+	seed := int64(7)
+	for _, args := range []ToolArgs{
+		{Seed: &seed},
+		{Seed: &seed, Count: 3},
+		{Seed: &seed, Mode: "authentic"},
+	} {
+		// Calls on a shared instance must not depend on what came before.
+		q := New()
+		_, first, err := q.RunTool(args, nil)
+		if err != nil {
+			t.Fatalf("RunTool(%+v): %v", args, err)
+		}
+		q.Generate()
+		_, second, err := q.RunTool(args, q.NewBag(false))
+		if err != nil {
+			t.Fatalf("RunTool(%+v): %v", args, err)
+		}
+		if first != second {
+			t.Errorf("RunTool(%+v) = %q, then %q", args, first, second)
+		}
+	}
+}
+
+func TestGenerateFilteredHonoursTags(t *testing.T) {
+	q := newTestQuotify(t, 1)
+	tests := []struct {
+		filter      Filter
+		quoteTag    string
+		authorTag   string
+		wantAuthors []string
+	}{
+		{filter: Filter{QuoteTags: []string{"history"}}, quoteTag: "history"},
+		{filter: Filter{QuoteTags: []string{"Politics", "HISTORY"}}, quoteTag: "politics"},
+		{filter: Filter{AuthorTags: []string{"wrestling"}}, authorTag: "wrestling"},
+		{filter: Filter{QuoteTags: []string{"movies"}, Authentic: true}, quoteTag: "movies", wantAuthors: []string{"Master Yoda"}},
+	}
+	for _, tt := range tests {
+		for range 50 {
+			quote, err := q.GenerateFiltered(tt.filter)
+			if err != nil {
+				t.Fatalf("GenerateFiltered(%+v): %v", tt.filter, err)
+			}
+			if tt.quoteTag != "" && !slices.Contains(quote.Tags, tt.quoteTag) {
+				t.Errorf("GenerateFiltered(%+v) = %+v, want quote tagged %s", tt.filter, quote, tt.quoteTag)
+			}
+			if tt.authorTag != "" && !slices.Contains(quote.AuthorTags, tt.authorTag) {
+				t.Errorf("GenerateFiltered(%+v) = %+v, want author tagged %s", tt.filter, quote, tt.authorTag)
+			}
+			if tt.wantAuthors != nil && !slices.Contains(tt.wantAuthors, quote.Author) {
+				t.Errorf("GenerateFiltered(%+v) = %+v, want author in %v", tt.filter, quote, tt.wantAuthors)
+			}
+		}
+	}
+}
+
+func TestGenerateFilteredNoMatch(t *testing.T) {
+	q := newTestQuotify(t, 1)
+	for _, f := range []Filter{
+		{QuoteTags: []string{"nope"}},
+		{AuthorTags: []string{"nope"}},
+		{QuoteTags: []string{"movies", "politics"}},
+		// The only tech quote has no known author.
+		{QuoteTags: []string{"tech"}, Authentic: true},
+	} {
+		_, err := q.GenerateFiltered(f)
+		if !errors.Is(err, ErrNoMatch) {
+			t.Errorf("GenerateFiltered(%+v) error = %v, want ErrNoMatch", f, err)
+		}
+	}
+}
+
+func quotesEqual(a, b Quote) bool {
+	return a.ID == b.ID && a.Text == b.Text && a.Author == b.Author
+}
+
+func TestToolArgsCategoryLeavesTagsAlone(t *testing.T) {
+	tags := make([]string, 1, 2)
+	tags[0] = "movies"
+	args := ToolArgs{Tags: tags, Category: "tech"}
+	filter, _, err := args.filter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"movies", "tech"}; !slices.Equal(filter.QuoteTags, want) {
+		t.Errorf("QuoteTags = %v, want %v", filter.QuoteTags, want)
+	}
+	if spare := tags[:2][1]; spare != "" {
+		t.Errorf("category written into the caller's tags: %q", spare)
+	}
+}
@@ -0,0 +1,159 @@
+package quotify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrResourceNotFound is returned by ReadResource for URIs that name no
+// corpus entry.
+var ErrResourceNotFound = errors.New("resource not found")
+
+// Corpus resource URIs. The quote templates take a quote ID (see
+// QuoteEntry.EffectiveID) and the author template a URL-escaped author name.
+const (
+	AuthorsURI           = "quotify://authors"
+	QuotesURI            = "quotify://quotes"
+	QuoteURITemplate     = "quotify://quotes/{id}"
+	QuoteCardURITemplate = "quotify://quotes/{id}/card"
+	AuthorURITemplate    = "quotify://authors/{name}"
+
+	quoteURIPrefix  = QuotesURI + "/"
+	cardURISuffix   = "/card"
+	authorURIPrefix = AuthorsURI + "/"
+)
+
+// ResourceMIMEType is the MIME type of the corpus resources other than quote
+// cards, which are CardMIMEType.
+const ResourceMIMEType = "application/json"
+
+// ResourceInfo describes a corpus resource, or a resource template when URI
+// is one of the templates above.
+type ResourceInfo struct {
+	Name        string
+	Description string
+	URI         string
+	MIMEType    string
+}
+
+// Resources lists the fixed corpus resources.
+var Resources = []ResourceInfo{
+	{Name: "authors", Description: "Every author in the corpus, with tags and weights", URI: AuthorsURI, MIMEType: ResourceMIMEType},
+	{Name: "quotes", Description: "Every quote in the corpus, with IDs, tags, weights and real attributions", URI: QuotesURI, MIMEType: ResourceMIMEType},
+}
+
+// ResourceTemplates lists the templates for single corpus entries.
+var ResourceTemplates = []ResourceInfo{
+	{Name: "quote", Description: "A single quote by ID", URI: QuoteURITemplate, MIMEType: ResourceMIMEType},
+	{Name: "quote_card", Description: "A single quote by ID as an SVG quote card, credited to whoever really said it", URI: QuoteCardURITemplate, MIMEType: CardMIMEType},
+	{Name: "author", Description: "A single author by name, with the quotes they really said", URI: AuthorURITemplate, MIMEType: ResourceMIMEType},
+}
+
+// QuoteURI returns the resource URI of the quote with the given ID.
+func QuoteURI(id string) string {
+	return quoteURIPrefix + url.PathEscape(id)
+}
+
+// QuoteCardURI returns the resource URI of the card of the quote with the
+// given ID.
+func QuoteCardURI(id string) string {
+	return QuoteURI(id) + cardURISuffix
+}
+
+// AuthorURI returns the resource URI of the named author.
+func AuthorURI(name string) string {
+	return authorURIPrefix + url.PathEscape(name)
+}
+
+// AuthorResource is an author as served by the author resources, with the
+// quotes they really said.
+type AuthorResource struct {
+	AuthorEntry
+	URI    string       `json:"uri"`
+	Quotes []QuoteEntry `json:"quotes,omitempty"`
+}
+
+// ReadResource returns the contents of the corpus resource at uri and their
+// MIME type: JSON for the author or quote lists, a single quote or a single
+// author, and an SVG image for a quote card. Real authors of quotes need not
+// be in the author list to be found. It returns an error wrapping
+// ErrResourceNotFound for unknown URIs.
+func (q *Quotify) ReadResource(uri string) ([]byte, string, error) {
+	notFound := fmt.Errorf("%w: %s", ErrResourceNotFound, uri)
+	var v any
+	switch uri {
+	case AuthorsURI:
+		authors := make([]AuthorResource, len(q.Authors))
+		for i, a := range q.Authors {
+			authors[i] = AuthorResource{AuthorEntry: a, URI: AuthorURI(a.Name)}
+		}
+		v = authors
+	case QuotesURI:
+		quotes := make([]QuoteEntry, len(q.Quotes))
+		for i, e := range q.Quotes {
+			quotes[i] = withID(e)
+		}
+		v = quotes
+	default:
+		if id, ok := resourceName(uri, quoteURIPrefix, cardURISuffix); ok {
+			e, ok := q.QuoteByID(id)
+			if !ok {
+				return nil, "", notFound
+			}
+			quote := q.attribute(e)
+			if quote.Author == "" {
+				quote.Author = "Anonymous"
+			}
+			return quote.Card(), CardMIMEType, nil
+		} else if id, ok := resourceName(uri, quoteURIPrefix, ""); ok {
+			e, ok := q.QuoteByID(id)
+			if !ok {
+				return nil, "", notFound
+			}
+			v = withID(e)
+		} else if name, ok := resourceName(uri, authorURIPrefix, ""); ok {
+			var quotes []QuoteEntry
+			for _, e := range q.QuotesBy(name) {
+				quotes = append(quotes, withID(e))
+			}
+			a, ok := q.Author(name)
+			if !ok && len(quotes) == 0 {
+				return nil, "", notFound
+			}
+			a.Name = name
+			v = AuthorResource{AuthorEntry: a, URI: AuthorURI(name), Quotes: quotes}
+		} else {
+			return nil, "", notFound
+		}
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	return data, ResourceMIMEType, err
+}
+
+// withID returns e with its ID filled in, so that clients can refer back to it.
+func withID(e QuoteEntry) QuoteEntry {
+	e.ID = e.EffectiveID()
+	return e
+}
+
+// resourceName returns the unescaped part of uri between prefix and
+// suffix. The suffix is cut before unescaping, so that an escaped name
+// ending in the suffix, such as the quote ID "a/card", is not mistaken for
+// it.
+func resourceName(uri, prefix, suffix string) (string, bool) {
+	rest, ok := strings.CutPrefix(uri, prefix)
+	if ok {
+		rest, ok = strings.CutSuffix(rest, suffix)
+	}
+	if !ok || rest == "" {
+		return "", false
+	}
+	name, err := url.PathUnescape(rest)
+	if err != nil {
+		return "", false
+	}
+	return name, true
+}
@@ -0,0 +1,47 @@
+package quotify
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestReadResourceSlashInID(t *testing.T) {
+	corpus := testCorpus()
+	corpus.Quotes[0].ID = "a"
+	corpus.Quotes[1].ID = "a/card"
+	q, err := NewFromCorpus(corpus, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, mimeType, err := q.ReadResource(QuoteURI("a/card"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e QuoteEntry
+	if err := json.Unmarshal(data, &e); err != nil {
+		t.Fatalf("quote a/card is %s, not JSON: %v", mimeType, err)
+	}
+	if e.ID != "a/card" {
+		t.Errorf("read quote %q, want a/card", e.ID)
+	}
+
+	for id, want := range map[string]string{"a": corpus.Quotes[0].Text, "a/card": corpus.Quotes[1].Text} {
+		data, mimeType, err := q.ReadResource(QuoteCardURI(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mimeType != CardMIMEType || !strings.Contains(string(data), want) {
+			t.Errorf("card of %q is %s %.60q..., want an SVG card of %q", id, mimeType, data, want)
+		}
+	}
+
+	for _, uri := range []string{QuoteURI("nope"), QuoteCardURI("nope"), quoteURIPrefix + "%zz", quoteURIPrefix + cardURISuffix} {
+		if _, _, err := q.ReadResource(uri); !errors.Is(err, ErrResourceNotFound) {
+			t.Errorf("ReadResource(%q) error = %v, want ErrResourceNotFound", uri, err)
+		}
+	}
+}
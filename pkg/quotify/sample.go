@@ -0,0 +1,52 @@
+package quotify
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// sampler draws corpus indices in proportion to their weights. It keeps a
+// cumulative weight table so each draw is a binary search.
+type sampler struct {
+	indices    []int
+	cumulative []float64
+}
+
+// newSampler builds a sampler over the given corpus indices.
+func newSampler(indices []int, weight func(int) float64) *sampler {
+	s := &sampler{
+		indices:    indices,
+		cumulative: make([]float64, len(indices)),
+	}
+	total := 0.0
+	for i, idx := range indices {
+		total += weight(idx)
+		s.cumulative[i] = total
+	}
+	return s
+}
+
+// len returns the number of indices the sampler can draw from.
+func (s *sampler) len() int {
+	return len(s.indices)
+}
+
+// pick returns a corpus index. The sampler must not be empty.
+func (s *sampler) pick(rng *rand.Rand) int {
+	total := s.cumulative[len(s.cumulative)-1]
+	x := rng.Float64() * total
+	i := sort.Search(len(s.cumulative), func(i int) bool { return s.cumulative[i] > x })
+	if i == len(s.cumulative) {
+		i--
+	}
+	return s.indices[i]
+}
+
+// allIndices returns 0, 1, ..., n-1.
+func allIndices(n int) []int {
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
@@ -0,0 +1,218 @@
+package quotify
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Search modes.
+const (
+	// SearchKeyword matches quotes containing any of the query's words,
+	// looking at the text, real author, source and tags.
+	SearchKeyword = "keyword"
+	// SearchPhrase matches quotes whose text contains the query's words
+	// consecutively.
+	SearchPhrase = "phrase"
+	// SearchRegex matches quotes whose text matches the query as a
+	// case-insensitive regular expression.
+	SearchRegex = "regex"
+)
+
+// BM25 parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// SearchHit is a quote found by Search.
+type SearchHit struct {
+	// Quote is the matching corpus entry, with its ID filled in.
+	Quote QuoteEntry `json:"quote"`
+	Score float64    `json:"score"`
+}
+
+// searchIndex is an inverted index over a corpus' quotes, used to rank
+// keyword and phrase searches by BM25.
+type searchIndex struct {
+	postings map[string][]posting
+	// text holds the tokens of each quote's text, for phrase matching.
+	text    [][]string
+	lengths []int
+	avgLen  float64
+}
+
+// posting records how often a term occurs in a quote.
+type posting struct {
+	doc, freq int
+}
+
+func newSearchIndex(quotes []QuoteEntry) *searchIndex {
+	idx := &searchIndex{
+		postings: make(map[string][]posting),
+		text:     make([][]string, len(quotes)),
+		lengths:  make([]int, len(quotes)),
+	}
+	total := 0
+	for doc, e := range quotes {
+		idx.text[doc] = tokenize(e.Text)
+		terms := append(append([]string(nil), idx.text[doc]...), tokenize(e.Author+" "+e.Source)...)
+		terms = append(terms, e.Tags...)
+
+		freqs := make(map[string]int)
+		for _, t := range terms {
+			freqs[t]++
+		}
+		for t, n := range freqs {
+			idx.postings[t] = append(idx.postings[t], posting{doc, n})
+		}
+		idx.lengths[doc] = len(terms)
+		total += len(terms)
+	}
+	if len(quotes) > 0 {
+		idx.avgLen = float64(total) / float64(len(quotes))
+	}
+	return idx
+}
+
+var apostrophes = strings.NewReplacer("'", "", "’", "")
+
+// tokenize splits s into lower-case words, dropping apostrophes so that
+// "don't" and "dont" match.
+func tokenize(s string) []string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\'' && r != '’'
+	})
+	tokens := words[:0]
+	for _, w := range words {
+		if w = apostrophes.Replace(w); w != "" {
+			tokens = append(tokens, w)
+		}
+	}
+	return tokens
+}
+
+// scores returns the BM25 score of every quote containing at least one of
+// terms.
+func (idx *searchIndex) scores(terms []string) map[int]float64 {
+	n := float64(len(idx.lengths))
+	scores := make(map[int]float64)
+	for _, t := range uniqueStrings(terms) {
+		postings := idx.postings[t]
+		if len(postings) == 0 {
+			continue
+		}
+		df := float64(len(postings))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for _, p := range postings {
+			tf := float64(p.freq)
+			norm := 1 - bm25B + bm25B*float64(idx.lengths[p.doc])/idx.avgLen
+			scores[p.doc] += idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+		}
+	}
+	return scores
+}
+
+// hasPhrase reports whether the text of quote doc contains terms in order.
+func (idx *searchIndex) hasPhrase(doc int, terms []string) bool {
+	text := idx.text[doc]
+	for i := 0; i+len(terms) <= len(text); i++ {
+		match := true
+		for j, t := range terms {
+			if text[i+j] != t {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+func uniqueStrings(ss []string) []string {
+	seen := make(map[string]bool, len(ss))
+	var out []string
+	for _, s := range ss {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Search finds the quotes matching query in the given mode (SearchKeyword if
+// empty), best matches first. Keyword and phrase searches are ranked by BM25;
+// regex searches by the number of matches. Quotes with equal scores keep
+// their corpus order.
+func (q *Quotify) Search(query, mode string) ([]SearchHit, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, errors.New("empty search query")
+	}
+	idx := q.searchIndex()
+
+	scores := make(map[int]float64)
+	switch mode {
+	case "", SearchKeyword:
+		terms := tokenize(query)
+		if len(terms) == 0 {
+			return nil, fmt.Errorf("search query %q has no words", query)
+		}
+		scores = idx.scores(terms)
+	case SearchPhrase:
+		terms := tokenize(query)
+		if len(terms) == 0 {
+			return nil, fmt.Errorf("search query %q has no words", query)
+		}
+		for doc, score := range idx.scores(terms) {
+			if idx.hasPhrase(doc, terms) {
+				scores[doc] = score
+			}
+		}
+	case SearchRegex:
+		re, err := regexp.Compile("(?i)" + query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression: %w", err)
+		}
+		for doc, e := range q.Quotes {
+			if n := len(re.FindAllStringIndex(e.Text, -1)); n > 0 {
+				scores[doc] = float64(n)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown search mode %q (want %s, %s or %s)", mode, SearchKeyword, SearchPhrase, SearchRegex)
+	}
+
+	docs := make([]int, 0, len(scores))
+	for doc := range scores {
+		docs = append(docs, doc)
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		if scores[docs[i]] != scores[docs[j]] {
+			return scores[docs[i]] > scores[docs[j]]
+		}
+		return docs[i] < docs[j]
+	})
+	hits := make([]SearchHit, len(docs))
+	for i, doc := range docs {
+		hits[i] = SearchHit{Quote: withID(q.Quotes[doc]), Score: scores[doc]}
+	}
+	return hits, nil
+}
+
+// searchIndex returns the index over q.Quotes. NewFromCorpus builds it up
+// front; a Quotify built as a struct literal builds it on first use.
+func (q *Quotify) searchIndex() *searchIndex {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.index == nil {
+		q.index = newSearchIndex(q.Quotes)
+	}
+	return q.index
+}
@@ -0,0 +1,211 @@
+package quotify
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+// ToolDescription describes the quotify tool to clients.
+const ToolDescription = "Generate a random quote with a random author attribution in the style of the original quotify Ruby gem"
+
+// MaxToolCount caps the number of quotes returned by a single tool call.
+const MaxToolCount = 100
+
+// ToolArgs are the arguments of the quotify tool, as offered by every server
+// that exposes it.
+type ToolArgs struct {
+	Format   string `json:"format,omitempty" jsonschema:"format for the quote output (default: text); 'template' renders the template argument"`
+	Template string `json:"template,omitempty" jsonschema:"Go text/template executed per quote when format is 'template', e.g. {{.Text}} ~ {{upper .Author}}"`
+
+	Seed   *int64 `json:"seed,omitempty" jsonschema:"optional seed; the same seed always produces the same quote and author"`
+	Count  int    `json:"count,omitempty" jsonschema:"number of quotes to return in one call (default 1)"`
+	Unique string `json:"unique,omitempty" jsonschema:"what must not repeat within a batch: 'quotes' (default), 'authors', 'both', 'pairs' or 'none'"`
+	Mode   string `json:"mode,omitempty" jsonschema:"'chaos' pairs quotes with random authors (default), 'authentic' credits the real author, 'quiz' hides the real author for a guessing game"`
+
+	Category   string   `json:"category,omitempty" jsonschema:"only pick quotes in this category, e.g. movies, politics, tech"`
+	Tags       []string `json:"tags,omitempty" jsonschema:"only pick quotes carrying all of these tags"`
+	AuthorTags []string `json:"author_tags,omitempty" jsonschema:"only attribute the quote to authors carrying all of these tags, e.g. wrestling"`
+
+	Cards bool `json:"cards,omitempty" jsonschema:"also return each quote as an SVG quote card image"`
+}
+
+// ToolResult is the structured output of the quotify tool.
+type ToolResult struct {
+	Quotes []Quote `json:"quotes" jsonschema:"the generated quotes, in order; each carries a stable id and its tags"`
+	Mode   string  `json:"mode" jsonschema:"the attribution mode used: chaos, authentic or quiz"`
+	Seed   *int64  `json:"seed,omitempty" jsonschema:"the seed the quotes were generated from, if one was given"`
+}
+
+// ToolFormats returns the values accepted by ToolArgs.Format: the registered
+// formatters plus "template".
+func ToolFormats() []string {
+	return append(FormatterNames(), "template")
+}
+
+// ArgumentError reports a tool argument that is invalid in itself, such as
+// an unknown mode or a template that does not parse, as opposed to a call
+// that could not be carried out, e.g. because no quote matched.
+type ArgumentError struct {
+	Arg string // the argument's JSON name, e.g. "count"
+	Err error
+}
+
+func (e *ArgumentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ArgumentError) Unwrap() error {
+	return e.Err
+}
+
+// RunTool carries out a quotify tool call, returning the quotes and their
+// rendering in the requested format. Quote cards, if asked for, are left to
+// the caller. Invalid arguments are reported with an *ArgumentError.
+//
+// Single unseeded quotes are dealt from bag if it is not nil, so that a
+// client does not see repeats; seeded calls must be reproducible and never
+// use it. Batches never use it either: they are drawn afresh, with no
+// repeats within the batch as set by args.Unique.
+func (q *Quotify) RunTool(args ToolArgs, bag *Bag) (ToolResult, string, error) {
+	filter, mode, err := args.filter()
+	if err != nil {
+		return ToolResult{}, "", err
+	}
+	formatter, err := args.formatter()
+	if err != nil {
+		return ToolResult{}, "", err
+	}
+
+	if args.Seed != nil {
+		q = q.WithSource(rand.NewSource(*args.Seed))
+		bag = nil
+	}
+
+	if args.Count < 0 {
+		return ToolResult{}, "", &ArgumentError{Arg: "count", Err: errors.New("count must not be negative")}
+	}
+	var quotes []Quote
+	if args.Count > 1 {
+		if args.Count > MaxToolCount {
+			return ToolResult{}, "", &ArgumentError{Arg: "count", Err: fmt.Errorf("count must be at most %d", MaxToolCount)}
+		}
+		opts := BatchOptions{Filter: filter}
+		switch args.Unique {
+		case "", "quotes":
+			opts.UniqueQuotes = true
+		case "authors":
+			opts.UniqueAuthors = true
+		case "both":
+			opts.UniqueQuotes, opts.UniqueAuthors = true, true
+		case "pairs":
+			opts.UniquePairs = true
+		case "none":
+		default:
+			return ToolResult{}, "", &ArgumentError{Arg: "unique", Err: fmt.Errorf("unknown unique option '%s' (want quotes, authors, both, pairs or none)", args.Unique)}
+		}
+		batch, err := q.GenerateN(args.Count, opts)
+		if err != nil {
+			return ToolResult{}, "", err
+		}
+		quotes = batch
+	} else {
+		var quote Quote
+		var err error
+		if bag != nil {
+			quote, err = bag.NextFiltered(filter)
+		} else {
+			quote, err = q.GenerateFiltered(filter)
+		}
+		if err != nil {
+			return ToolResult{}, "", err
+		}
+		quotes = []Quote{quote}
+	}
+	if mode == "quiz" {
+		for i := range quotes {
+			quotes[i] = quotes[i].Hidden()
+		}
+	}
+
+	var b strings.Builder
+	if err := formatter.Format(&b, quotes); err != nil {
+		return ToolResult{}, "", err
+	}
+	text := strings.TrimRight(b.String(), "\n")
+
+	return ToolResult{Quotes: quotes, Mode: mode, Seed: args.Seed}, text, nil
+}
+
+// formatter returns the formatter selected by args.
+func (args ToolArgs) formatter() (Formatter, error) {
+	switch args.Format {
+	case "":
+		f, _ := LookupFormatter("text")
+		return f, nil
+	case "template":
+		if args.Template == "" {
+			return nil, &ArgumentError{Arg: "template", Err: errors.New("the template format needs a 'template' argument")}
+		}
+		f, err := NewTemplateFormatter(args.Template)
+		if err != nil {
+			return nil, &ArgumentError{Arg: "template", Err: err}
+		}
+		return f, nil
+	}
+	f, ok := LookupFormatter(args.Format)
+	if !ok {
+		return nil, &ArgumentError{Arg: "format", Err: fmt.Errorf("unknown format %q (want one of %s)", args.Format, strings.Join(ToolFormats(), ", "))}
+	}
+	return f, nil
+}
+
+// filter returns the filter and the attribution mode selected by args.
+func (args ToolArgs) filter() (Filter, string, error) {
+	filter := Filter{
+		QuoteTags:  args.Tags,
+		AuthorTags: args.AuthorTags,
+	}
+	if args.Category != "" {
+		filter.QuoteTags = slices.Concat(filter.QuoteTags, []string{args.Category})
+	}
+	mode := args.Mode
+	switch mode {
+	case "":
+		mode = "chaos"
+	case "chaos":
+	case "authentic", "quiz":
+		filter.Authentic = true
+	default:
+		return Filter{}, "", &ArgumentError{Arg: "mode", Err: fmt.Errorf("unknown mode '%s' (want chaos, authentic or quiz)", args.Mode)}
+	}
+	return filter, mode, nil
+}
+
+// Feed returns a function generating an endless sequence of single quotes
+// for the mode, filters and seed in args, for streaming quotes one at a
+// time. Unlike with RunTool, a seed fixes the whole sequence rather than
+// each quote. Invalid arguments are reported with an *ArgumentError. The
+// function is safe for concurrent use, though concurrent callers share, and
+// so split, a seeded sequence.
+func (q *Quotify) Feed(args ToolArgs) (func() (Quote, error), error) {
+	filter, mode, err := args.filter()
+	if err != nil {
+		return nil, err
+	}
+	if args.Seed != nil {
+		q = q.WithSource(rand.NewSource(*args.Seed))
+	}
+	return func() (Quote, error) {
+		quote, err := q.GenerateFiltered(filter)
+		if err != nil {
+			return Quote{}, err
+		}
+		if mode == "quiz" {
+			quote = quote.Hidden()
+		}
+		return quote, nil
+	}, nil
+}
@@ -0,0 +1,53 @@
+package quotify
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRunToolCount(t *testing.T) {
+	q := NewWithSeed(1)
+	for _, count := range []int{-4, -1, MaxToolCount + 1} {
+		if _, _, err := q.RunTool(ToolArgs{Count: count}, nil); err == nil {
+			t.Errorf("RunTool with count %d succeeded", count)
+		}
+	}
+	for count, want := range map[int]int{0: 1, 1: 1, 5: 5} {
+		result, _, err := q.RunTool(ToolArgs{Count: count}, nil)
+		if err != nil {
+			t.Fatalf("RunTool with count %d: %v", count, err)
+		}
+		if len(result.Quotes) != want {
+			t.Errorf("RunTool with count %d returned %d quotes, want %d", count, len(result.Quotes), want)
+		}
+	}
+}
+
+func TestRunToolArgumentErrors(t *testing.T) {
+	q := NewWithSeed(1)
+	for _, tt := range []struct {
+		args ToolArgs
+		arg  string
+	}{
+		{ToolArgs{Mode: "nope"}, "mode"},
+		{ToolArgs{Format: "nope"}, "format"},
+		{ToolArgs{Format: "template"}, "template"},
+		{ToolArgs{Format: "template", Template: "{{"}, "template"},
+		{ToolArgs{Count: -1}, "count"},
+		{ToolArgs{Count: MaxToolCount + 1}, "count"},
+		{ToolArgs{Count: 2, Unique: "nope"}, "unique"},
+	} {
+		_, _, err := q.RunTool(tt.args, nil)
+		var argErr *ArgumentError
+		if !errors.As(err, &argErr) || argErr.Arg != tt.arg {
+			t.Errorf("RunTool(%+v) error = %v, want an ArgumentError for %s", tt.args, err, tt.arg)
+		}
+	}
+
+	// Failures that are not down to a single argument are not ArgumentErrors.
+	_, _, err := q.RunTool(ToolArgs{Tags: []string{"nope"}}, nil)
+	var argErr *ArgumentError
+	if !errors.Is(err, ErrNoMatch) || errors.As(err, &argErr) {
+		t.Errorf("RunTool with an unknown tag error = %#v, want ErrNoMatch", err)
+	}
+}
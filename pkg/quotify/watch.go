@@ -0,0 +1,114 @@
+package quotify
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// Watcher polls a corpus file or directory and reloads it when it changes.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	stamp    string
+}
+
+// NewWatcher returns a Watcher for the corpus at path that polls every
+// interval. The current state of path is taken as the baseline, so create
+// the watcher before the initial Load to avoid missing an early edit.
+func NewWatcher(path string, interval time.Duration) *Watcher {
+	return &Watcher{
+		path:     path,
+		interval: interval,
+		stamp:    corpusStamp(path),
+	}
+}
+
+// Run polls until ctx is done. Whenever the corpus on disk changes it is
+// loaded and validated, and reload is called with either the new Quotify or
+// the error that prevented it from loading. On error the caller should keep
+// serving its previous instance; the watcher retries on the next change.
+func (w *Watcher) Run(ctx context.Context, reload func(*Quotify, error)) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		stamp := corpusStamp(w.path)
+		if stamp == w.stamp {
+			continue
+		}
+		w.stamp = stamp
+		reload(Load(w.path))
+	}
+}
+
+// LoadAndWatch loads the corpus at path and passes it to store. If interval
+// is positive it then polls path until ctx is done, passing every corpus
+// that reloads cleanly to store as well; failed reloads are logged and the
+// previous corpus is kept. Loads and reloads are logged.
+func LoadAndWatch(ctx context.Context, path string, interval time.Duration, store func(*Quotify)) error {
+	var watcher *Watcher
+	if interval > 0 {
+		watcher = NewWatcher(path, interval)
+	}
+	q, err := Load(path)
+	if err != nil {
+		return fmt.Errorf("loading corpus from %s: %w", path, err)
+	}
+	store(q)
+	log.Printf("Loaded %d quotes and %d authors from %s", len(q.Quotes), len(q.Authors), path)
+
+	if watcher != nil {
+		go watcher.Run(ctx, func(q *Quotify, err error) {
+			if err != nil {
+				log.Printf("Corpus reload failed, keeping previous corpus: %v", err)
+				return
+			}
+			store(q)
+			log.Printf("Reloaded %d quotes and %d authors from %s", len(q.Quotes), len(q.Authors), path)
+		})
+	}
+	return nil
+}
+
+// corpusStamp summarises the name, size and modification time of every
+// corpus file under path. Any change to the set of files or their contents
+// (as seen by the file system) changes the stamp.
+func corpusStamp(path string) string {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	files := []string{path}
+	if info.IsDir() {
+		if files, err = CorpusFiles(path); err != nil {
+			return "error: " + err.Error()
+		}
+	}
+	return FileStamp(files...)
+}
+
+// FileStamp summarises the name, size and modification time of files, for
+// detecting changes by polling. Any change to the files as seen by the file
+// system, including one appearing or disappearing, changes the stamp.
+func FileStamp(files ...string) string {
+	var b strings.Builder
+	for _, file := range files {
+		fi, err := os.Stat(file)
+		if err != nil {
+			fmt.Fprintf(&b, "%s:error;", file)
+			continue
+		}
+		fmt.Fprintf(&b, "%s:%d:%d;", file, fi.Size(), fi.ModTime().UnixNano())
+	}
+	return b.String()
+}